@@ -3,8 +3,11 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
+	"strconv"
+	"strings"
 	"syscall"
 
 	"github.com/naotama2002/todoist-go-mcp-server/pkg/log"
@@ -16,6 +19,13 @@ func main() {
 	mode := flag.String("mode", "http", "Server mode: 'http' or 'stdio'")
 	addr := flag.String("addr", ":8080", "Address to listen on (HTTP mode only)")
 	token := flag.String("token", "", "Todoist API token")
+	rateLimit := flag.Float64("rate-limit", 0, "Maximum average Todoist API requests per second; requests over the limit are queued (default 0, which disables the limit)")
+	rateBurst := flag.Int("rate-burst", 10, "Number of Todoist API requests allowed in a burst above the rate limit")
+	syncMaxStaleness := flag.Duration("sync-max-staleness", 0, "Serve read tools from a local Sync API mirror that is at most this old, e.g. 30s (0 disables the mirror)")
+	syncCacheFile := flag.String("sync-cache-file", "", "File to persist the Sync API mirror in between runs (requires -sync-max-staleness)")
+	toolsetNames := flag.String("toolsets", "", "Comma-separated toolsets to expose: tasks, projects, labels, sections, comments, batch, or all (default \"all\", or none with -dynamic-toolsets)")
+	readOnly := flag.Bool("read-only", false, "Expose only the tools that do not change any Todoist data")
+	dynamicToolsets := flag.Bool("dynamic-toolsets", false, "Add meta-tools with which clients list and enable toolsets during a session")
 	flag.Parse()
 
 	// Create logger
@@ -29,8 +39,44 @@ func main() {
 		}
 	}
 
+	// Get toolset options from environment variables if not provided
+	if err := boolFromEnv(readOnly, "read-only", "TODOIST_READ_ONLY"); err != nil {
+		logger.Fatal(err)
+	}
+	if err := boolFromEnv(dynamicToolsets, "dynamic-toolsets", "TODOIST_DYNAMIC_TOOLSETS"); err != nil {
+		logger.Fatal(err)
+	}
+	if *toolsetNames == "" {
+		*toolsetNames = os.Getenv("TODOIST_TOOLSETS")
+		if *toolsetNames == "" && !*dynamicToolsets {
+			*toolsetNames = "all"
+		}
+	}
+
+	if *rateLimit < 0 || *rateBurst < 1 {
+		logger.Fatal("Invalid rate limit: -rate-limit must not be negative and -rate-burst must be at least 1")
+	}
+
 	// Create the server
-	server := todoist.NewServer(*token, logger)
+	server := todoist.NewServer(*token, logger, todoist.WithRateLimit(*rateLimit, *rateBurst))
+
+	if *dynamicToolsets {
+		server.EnableDynamicToolsets()
+	}
+	if err := server.ConfigureToolsets(splitList(*toolsetNames), *readOnly); err != nil {
+		logger.WithError(err).Fatal("Invalid toolsets: set them with -toolsets flag or TODOIST_TOOLSETS environment variable")
+	}
+
+	if *syncMaxStaleness < 0 {
+		logger.Fatal("Invalid sync staleness: -sync-max-staleness must not be negative")
+	}
+	if *syncMaxStaleness > 0 {
+		if err := server.EnableSyncMirror(*syncMaxStaleness, *syncCacheFile); err != nil {
+			logger.WithError(err).Fatal("Failed to enable the Sync API mirror")
+		}
+	} else if *syncCacheFile != "" {
+		logger.Fatal("-sync-cache-file requires -sync-max-staleness")
+	}
 
 	// Handle graceful shutdown
 	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
@@ -68,3 +114,36 @@ func main() {
 		}
 	}
 }
+
+// boolFromEnv sets a boolean flag from an environment variable, unless the flag was given on
+// the command line
+func boolFromEnv(value *bool, flagName, envName string) error {
+	set := false
+	flag.Visit(func(f *flag.Flag) {
+		if f.Name == flagName {
+			set = true
+		}
+	})
+	env := os.Getenv(envName)
+	if set || env == "" {
+		return nil
+	}
+
+	parsed, err := strconv.ParseBool(env)
+	if err != nil {
+		return fmt.Errorf("invalid %s value %q: must be true or false", envName, env)
+	}
+	*value = parsed
+	return nil
+}
+
+// splitList splits a comma-separated list, ignoring surrounding spaces and empty items
+func splitList(list string) []string {
+	var items []string
+	for _, item := range strings.Split(list, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			items = append(items, item)
+		}
+	}
+	return items
+}
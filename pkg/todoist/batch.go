@@ -0,0 +1,259 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// maxBatchOperations is the largest number of commands the Sync API accepts in one request
+const maxBatchOperations = 100
+
+// batchCommandTypes maps the operation types of todoist_batch to Sync API command types
+var batchCommandTypes = map[string]string{
+	"task_add":       "item_add",
+	"task_update":    "item_update",
+	"task_move":      "item_move",
+	"task_close":     "item_close",
+	"task_delete":    "item_delete",
+	"project_add":    "project_add",
+	"project_update": "project_update",
+	"project_move":   "project_move",
+	"project_delete": "project_delete",
+	"section_add":    "section_add",
+	"section_update": "section_update",
+	"section_move":   "section_move",
+	"section_delete": "section_delete",
+	"label_add":      "label_add",
+	"label_update":   "label_update",
+	"label_delete":   "label_delete",
+}
+
+// batchOperationTypes returns the operation types of todoist_batch in sorted order
+func batchOperationTypes() []string {
+	types := make([]string, 0, len(batchCommandTypes))
+	for operationType := range batchCommandTypes {
+		types = append(types, operationType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// BatchOperation is one operation of a todoist_batch call
+type BatchOperation struct {
+	Type   string                 `json:"type"`
+	TempID string                 `json:"tempId,omitempty"`
+	Args   map[string]interface{} `json:"args"`
+}
+
+// BatchOperationResult is the outcome of one operation of a todoist_batch call
+type BatchOperationResult struct {
+	Index  int    `json:"index"`
+	Type   string `json:"type"`
+	TempID string `json:"tempId,omitempty"`
+	ID     string `json:"id,omitempty"`
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+// BatchResponse represents the response for the todoist_batch tool
+type BatchResponse struct {
+	Results       []BatchOperationResult `json:"results"`
+	TempIDMapping map[string]string      `json:"tempIdMapping"`
+	Failed        int                    `json:"failed"`
+}
+
+// Batch returns the todoist_batch tool
+func (tp *ToolProvider) Batch() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"operations"},
+		"properties": map[string]interface{}{
+			"operations": map[string]interface{}{
+				"type":        "array",
+				"description": fmt.Sprintf("The operations to perform in order (required, at most %d). Operations that create an object can be given a tempId, which later operations may use in place of the new object's ID, e.g. as the project_id or parent_id of a task.", maxBatchOperations),
+				"minItems":    1,
+				"maxItems":    maxBatchOperations,
+				"items": map[string]interface{}{
+					"type":     "object",
+					"required": []string{"type"},
+					"properties": map[string]interface{}{
+						"type": map[string]interface{}{
+							"type":        "string",
+							"description": "The operation to perform.",
+							"enum":        batchOperationTypes(),
+						},
+						"tempId": map[string]interface{}{
+							"type":        "string",
+							"description": "Temporary ID of the object created by an *_add operation, e.g. 'project-1'. Generated if omitted.",
+						},
+						"args": map[string]interface{}{
+							"type":        "object",
+							"description": "Arguments of the operation as accepted by the corresponding Todoist Sync API command, in snake_case. For example {\"name\": \"Trip\"} for project_add, {\"content\": \"Book hotel\", \"project_id\": \"project-1\"} for task_add, {\"id\": \"6X7rM8997g3RQmvh\", \"section_id\": \"6Jf8VQXxpwv56VQ7\"} for task_move, and {\"id\": \"6X7rM8997g3RQmvh\"} for task_close or any *_delete operation.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[BatchResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_batch",
+		Description:  "Perform several task, project, section and label operations in a single request, e.g. create a project with its sections, tasks and subtasks. Operations run in order and report their own status; a failed operation does not undo the ones before it. Returns the status of each operation and the real IDs of created objects by tempId.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleBatch handles the todoist_batch tool request
+func (tp *ToolProvider) HandleBatch(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Derive the command UUIDs and generated temp IDs from the request ID, so that
+	// repeating the call with the same requestId repeats them and Todoist skips the
+	// commands it has already applied
+	requestID, ok := requestIDFrom(ctx)
+	if !ok {
+		requestID = newUUID()
+	}
+	batchID := func(kind string, i int) string {
+		return derivedUUID(requestID + "/batch/" + kind + "/" + strconv.Itoa(i))
+	}
+
+	// Parse parameters
+	operations, err := batchOperationsParam(request, "operations", func(i int) string {
+		return batchID("temp", i)
+	})
+	if err != nil {
+		return newToolResultError("Invalid parameter: operations", err), nil
+	}
+
+	commands := make([]BatchCommand, len(operations))
+	for i, operation := range operations {
+		commands[i] = BatchCommand{
+			Type:   batchCommandTypes[operation.Type],
+			UUID:   batchID("command", i),
+			TempID: operation.TempID,
+			Args:   operation.Args,
+		}
+	}
+
+	// Log the request
+	tp.logger.WithField("operations", len(commands)).Info("Executing batch")
+
+	// Call the Todoist API
+	batchResult, err := tp.client.ExecuteBatch(ctx, commands)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to execute batch")
+		return newToolResultError("Failed to execute batch", err), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	response := BatchResponse{
+		Results:       make([]BatchOperationResult, len(operations)),
+		TempIDMapping: batchResult.TempIDMapping,
+	}
+	for i, operation := range operations {
+		opResult := BatchOperationResult{
+			Index:  i,
+			Type:   operation.Type,
+			TempID: operation.TempID,
+			ID:     batchResult.TempIDMapping[operation.TempID],
+			Status: "ok",
+		}
+		if cmdErr, failed := batchResult.Errors[commands[i].UUID]; failed {
+			opResult.Status = "error"
+			opResult.Error = cmdErr.Error()
+			response.Failed++
+		}
+		response.Results[i] = opResult
+	}
+
+	if response.Failed > 0 {
+		tp.logger.WithField("failed", response.Failed).Warn("Some batch operations failed")
+	}
+
+	// Report failed operations as an error, together with the results of all operations
+	result := jsonResult(response)
+	result.IsError = response.Failed > 0
+	return result, nil
+}
+
+// batchOperationsParam parses and validates the operations of a todoist_batch call.
+// Operations that create an object without a tempId are given one by newTempID,
+// which is passed the index of the operation.
+func batchOperationsParam(r *mcp.CallToolRequest, p string, newTempID func(i int) string) ([]BatchOperation, error) {
+	args, err := getArguments(r)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := args[p].([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("parameter %s must be a non-empty array", p)
+	}
+	if len(items) > maxBatchOperations {
+		return nil, fmt.Errorf("parameter %s may contain at most %d operations", p, maxBatchOperations)
+	}
+
+	tempIDs := map[string]bool{}
+	operations := make([]BatchOperation, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("parameter %s[%d] is not an object", p, i)
+		}
+
+		operationType, _ := obj["type"].(string)
+		if _, ok := batchCommandTypes[operationType]; !ok {
+			return nil, fmt.Errorf("parameter %s[%d].type must be one of %s", p, i, strings.Join(batchOperationTypes(), ", "))
+		}
+
+		operationArgs := map[string]interface{}{}
+		if raw, present := obj["args"]; present {
+			operationArgs, ok = raw.(map[string]interface{})
+			if !ok {
+				return nil, fmt.Errorf("parameter %s[%d].args must be an object", p, i)
+			}
+		}
+
+		tempID, _ := obj["tempId"].(string)
+		creates := strings.HasSuffix(operationType, "_add")
+		if tempID != "" && !creates {
+			return nil, fmt.Errorf("parameter %s[%d].tempId is only allowed for *_add operations", p, i)
+		}
+		if creates && tempID == "" {
+			tempID = newTempID(i)
+		}
+		if tempID != "" {
+			if tempIDs[tempID] {
+				return nil, fmt.Errorf("parameter %s[%d].tempId %q is used more than once", p, i, tempID)
+			}
+			tempIDs[tempID] = true
+		}
+
+		operations[i] = BatchOperation{Type: operationType, TempID: tempID, Args: operationArgs}
+	}
+
+	return operations, nil
+}
@@ -0,0 +1,181 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBatchTool(t *testing.T) {
+	tp := NewMockToolProvider()
+	tool := tp.Batch()
+
+	assert.Equal(t, "todoist_batch", tool.Name)
+	assert.Contains(t, tool.Description, "single request")
+	assert.Contains(t, string(tool.InputSchema.(json.RawMessage)), `"task_add"`)
+}
+
+func TestHandleBatch(t *testing.T) {
+	var commands []syncCommand
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/sync", req.URL.Path)
+		assert.NoError(t, req.ParseForm())
+		assert.NoError(t, json.Unmarshal([]byte(req.PostForm.Get("commands")), &commands))
+
+		syncStatus := map[string]interface{}{}
+		tempIDMapping := map[string]string{}
+		for i, command := range commands {
+			syncStatus[command.UUID] = "ok"
+			if command.TempID != "" {
+				tempIDMapping[command.TempID] = "real-" + command.TempID
+			}
+			if i == 3 {
+				syncStatus[command.UUID] = map[string]interface{}{"error_code": 22, "error": "Item not found"}
+			}
+		}
+		return MockResponse(200, map[string]interface{}{
+			"sync_status":     syncStatus,
+			"temp_id_mapping": tempIDMapping,
+		}), nil
+	})
+
+	result, err := tp.HandleBatch(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"operations": []interface{}{
+			map[string]interface{}{"type": "project_add", "tempId": "trip", "args": map[string]interface{}{"name": "Trip"}},
+			map[string]interface{}{"type": "task_add", "tempId": "book", "args": map[string]interface{}{"content": "Book hotel", "project_id": "trip"}},
+			map[string]interface{}{"type": "task_add", "args": map[string]interface{}{"content": "Compare prices", "parent_id": "book"}},
+			map[string]interface{}{"type": "task_close", "args": map[string]interface{}{"id": "missing"}},
+		},
+	}))
+	require.NoError(t, err)
+
+	// The operations are sent as Sync commands in order
+	require.Len(t, commands, 4)
+	assert.Equal(t, "project_add", commands[0].Type)
+	assert.Equal(t, "trip", commands[0].TempID)
+	assert.Equal(t, "item_add", commands[1].Type)
+	assert.Equal(t, "trip", commands[1].Args.(map[string]interface{})["project_id"])
+	assert.NotEmpty(t, commands[2].TempID, "a temp_id is generated for add operations")
+	assert.Equal(t, "item_close", commands[3].Type)
+	assert.Empty(t, commands[3].TempID)
+
+	// The failed operation makes the result an error, but every operation is reported
+	assert.True(t, result.IsError)
+	var response BatchResponse
+	require.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Equal(t, 1, response.Failed)
+	require.Len(t, response.Results, 4)
+	assert.Equal(t, BatchOperationResult{Index: 0, Type: "project_add", TempID: "trip", ID: "real-trip", Status: "ok"}, response.Results[0])
+	assert.Equal(t, "real-book", response.Results[1].ID)
+	assert.Equal(t, "real-"+commands[2].TempID, response.Results[2].ID)
+	assert.Equal(t, "error", response.Results[3].Status)
+	assert.Contains(t, response.Results[3].Error, "Item not found")
+	assert.Equal(t, "real-trip", response.TempIDMapping["trip"])
+}
+
+func TestBatchRequestID(t *testing.T) {
+	var commands []syncCommand
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		commands = nil
+		assert.NoError(t, req.ParseForm())
+		assert.NoError(t, json.Unmarshal([]byte(req.PostForm.Get("commands")), &commands))
+		return MockResponse(200, map[string]interface{}{"sync_status": map[string]interface{}{}}), nil
+	})
+	tool := tp.withRequestID(toolsets.NewServerTool(tp.Batch(), tp.HandleBatch))
+
+	run := func(requestID string) []syncCommand {
+		_, err := tool.Handler(context.Background(), MockCallToolRequest(map[string]interface{}{
+			"requestId": requestID,
+			"operations": []interface{}{
+				map[string]interface{}{"type": "task_add", "args": map[string]interface{}{"content": "Book hotel"}},
+				map[string]interface{}{"type": "task_close", "args": map[string]interface{}{"id": "6X7rM8997g3RQmvh"}},
+			},
+		}))
+		require.NoError(t, err)
+		require.Len(t, commands, 2)
+		return commands
+	}
+
+	first := run("op-1")
+	assert.Regexp(t, uuidPattern, first[0].UUID)
+	assert.NotEqual(t, first[0].UUID, first[1].UUID)
+
+	// Repeating the call with the same requestId sends the same command UUIDs and temp IDs,
+	// so Todoist does not apply the commands twice
+	assert.Equal(t, first, run("op-1"))
+
+	// Another requestId gets other command UUIDs
+	other := run("op-2")
+	assert.NotEqual(t, first[0].UUID, other[0].UUID)
+	assert.NotEqual(t, first[0].TempID, other[0].TempID)
+}
+
+func TestHandleBatchInvalidOperations(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+
+	tooMany := make([]interface{}, maxBatchOperations+1)
+	for i := range tooMany {
+		tooMany[i] = map[string]interface{}{"type": "task_close", "args": map[string]interface{}{"id": "1"}}
+	}
+
+	tests := []struct {
+		name       string
+		operations interface{}
+		wantText   string
+	}{
+		{
+			name:       "missing operations",
+			operations: nil,
+			wantText:   "non-empty array",
+		},
+		{
+			name:       "too many operations",
+			operations: tooMany,
+			wantText:   "at most 100",
+		},
+		{
+			name:       "unknown type",
+			operations: []interface{}{map[string]interface{}{"type": "task_rename"}},
+			wantText:   "must be one of",
+		},
+		{
+			name:       "args not an object",
+			operations: []interface{}{map[string]interface{}{"type": "task_add", "args": "Buy milk"}},
+			wantText:   "args must be an object",
+		},
+		{
+			name:       "tempId on an update",
+			operations: []interface{}{map[string]interface{}{"type": "task_update", "tempId": "x"}},
+			wantText:   "only allowed for *_add",
+		},
+		{
+			name: "duplicate tempId",
+			operations: []interface{}{
+				map[string]interface{}{"type": "task_add", "tempId": "x"},
+				map[string]interface{}{"type": "label_add", "tempId": "x"},
+			},
+			wantText: "used more than once",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := map[string]interface{}{}
+			if tt.operations != nil {
+				params["operations"] = tt.operations
+			}
+			result, err := tp.HandleBatch(context.Background(), MockCallToolRequest(params))
+			assert.NoError(t, err)
+			assert.True(t, result.IsError)
+			assert.Contains(t, resultText(result), tt.wantText)
+		})
+	}
+}
@@ -1,6 +1,7 @@
 package todoist
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"io"
@@ -16,14 +17,20 @@ const (
 	TodoistAPIBaseURL = "https://api.todoist.com/api/v1"
 	// DefaultTimeout is the default timeout for HTTP requests
 	DefaultTimeout = 10 * time.Second
+	// DefaultPageSize is the default number of items requested per page from list endpoints
+	DefaultPageSize = 200
 )
 
 // Client represents a Todoist API client
 type Client struct {
-	httpClient *http.Client
-	token      string
-	baseURL    string
-	logger     *logrus.Logger
+	httpClient  *http.Client
+	token       string
+	baseURL     string
+	logger      *logrus.Logger
+	pageSize    int
+	maxItems    int
+	retryPolicy RetryPolicy
+	limiter     *rateLimiter
 }
 
 // ClientOption is a function that configures a Client
@@ -50,6 +57,21 @@ func WithLogger(logger *logrus.Logger) ClientOption {
 	}
 }
 
+// WithPageSize sets the number of items requested per page from list endpoints
+func WithPageSize(pageSize int) ClientOption {
+	return func(c *Client) {
+		c.pageSize = pageSize
+	}
+}
+
+// WithMaxItems caps the total number of items returned by list calls.
+// A value of zero or less means no limit.
+func WithMaxItems(maxItems int) ClientOption {
+	return func(c *Client) {
+		c.maxItems = maxItems
+	}
+}
+
 // NewClient creates a new Todoist API client
 func NewClient(token string, options ...ClientOption) *Client {
 	if token == "" {
@@ -70,9 +92,10 @@ func NewClient(token string, options ...ClientOption) *Client {
 				IdleConnTimeout:     90 * time.Second,
 			},
 		},
-		token:   token,
-		baseURL: TodoistAPIBaseURL,
-		logger:  logger,
+		token:    token,
+		baseURL:  TodoistAPIBaseURL,
+		logger:   logger,
+		pageSize: DefaultPageSize,
 	}
 
 	// Apply options
@@ -83,10 +106,25 @@ func NewClient(token string, options ...ClientOption) *Client {
 	return client
 }
 
-// doRequest performs an HTTP request and returns the response
+// doRequest performs an HTTP request with an optional JSON body and returns the response
 func (c *Client) doRequest(ctx context.Context, method, endpoint string, body io.Reader) (*http.Response, error) {
+	return c.doRequestWithContentType(ctx, method, endpoint, body, "application/json")
+}
+
+// doRequestWithContentType performs an HTTP request whose body has the given content type
+func (c *Client) doRequestWithContentType(ctx context.Context, method, endpoint string, body io.Reader, contentType string) (*http.Response, error) {
+	// Buffer the body so that it can be sent again on retries
+	var reqBody io.Reader
+	if body != nil {
+		bodyBytes, err := io.ReadAll(body)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read request body: %w", err)
+		}
+		reqBody = bytes.NewReader(bodyBytes)
+	}
+
 	// Create request
-	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, body)
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+endpoint, reqBody)
 	if err != nil {
 		return nil, fmt.Errorf("failed to create request: %w", err)
 	}
@@ -96,16 +134,72 @@ func (c *Client) doRequest(ctx context.Context, method, endpoint string, body io
 
 	// Add content type for requests with body
 	if body != nil {
-		req.Header.Add("Content-Type", "application/json")
+		req.Header.Add("Content-Type", contentType)
 	}
 
-	// Execute the request
-	resp, err := c.httpClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("failed to execute request: %w", err)
+	// Add an idempotency key to mutating requests; retries reuse it
+	if isMutatingMethod(method) {
+		requestID := idempotencyKey(ctx)
+		req.Header.Set(idempotencyKeyHeader, requestID)
+		c.logger.WithFields(map[string]interface{}{
+			"method":    method,
+			"endpoint":  endpoint,
+			"requestId": requestID,
+		}).Debug("Sending mutating request")
 	}
 
-	return resp, nil
+	// Execute the request
+	return c.retryDo(req)
+}
+
+// retryDo sends the request, retrying transient failures according to the client's retry policy.
+// Each attempt sends a copy of req with a fresh body.
+func (c *Client) retryDo(req *http.Request) (*http.Response, error) {
+	for attempt := 1; ; attempt++ {
+		attemptReq := req.Clone(req.Context())
+		if req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, fmt.Errorf("failed to create request: %w", err)
+			}
+			attemptReq.Body = body
+		}
+
+		// Every attempt counts against the rate limit
+		if err := c.waitForRateLimit(req.Context()); err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+
+		resp, err := c.httpClient.Do(attemptReq)
+
+		delay, retry := c.retryPolicy.retryDelay(attemptReq, resp, err, attempt)
+		if !retry {
+			if err != nil {
+				return nil, fmt.Errorf("failed to execute request: %w", err)
+			}
+			return resp, nil
+		}
+
+		// Discard the failed response before trying again
+		fields := map[string]interface{}{
+			"method":  req.Method,
+			"url":     req.URL.Path,
+			"attempt": attempt,
+			"delay":   delay,
+		}
+		if err != nil {
+			fields["error"] = err.Error()
+		} else {
+			fields["status"] = resp.StatusCode
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+		c.logger.WithFields(fields).Warn("Retrying request")
+
+		if err := sleepContext(req.Context(), delay); err != nil {
+			return nil, fmt.Errorf("failed to execute request: %w", err)
+		}
+	}
 }
 
 // processResponse processes the HTTP response and handles errors
@@ -119,7 +213,7 @@ func (c *Client) processResponse(resp *http.Response, expectedStatus int) ([]byt
 	// Check response status
 	if resp.StatusCode != expectedStatus {
 		bodyBytes, _ := io.ReadAll(resp.Body)
-		return nil, fmt.Errorf("API request failed with status %d: %s", resp.StatusCode, string(bodyBytes))
+		return nil, newAPIError(resp, bodyBytes)
 	}
 
 	// For status codes that don't return content (like 204 No Content)
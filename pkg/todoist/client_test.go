@@ -2,9 +2,11 @@ package todoist
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
@@ -113,6 +115,179 @@ func TestGetTasksFilterEndpoint(t *testing.T) {
 	assert.Len(t, tasks, 1)
 }
 
+func TestGetTasksPagination(t *testing.T) {
+	// Serve three pages linked by next_cursor
+	pages := map[string]PaginatedResponse[Task]{
+		"":   {Results: []Task{{ID: "1"}, {ID: "2"}}, NextCursor: strPtr("c1")},
+		"c1": {Results: []Task{{ID: "3"}, {ID: "4"}}, NextCursor: strPtr("c2")},
+		"c2": {Results: []Task{{ID: "5"}}, NextCursor: nil},
+	}
+
+	tests := []struct {
+		name      string
+		options   []ClientOption
+		wantIDs   []string
+		wantCalls int
+	}{
+		{
+			name:      "follows next_cursor until exhausted",
+			wantIDs:   []string{"1", "2", "3", "4", "5"},
+			wantCalls: 3,
+		},
+		{
+			name:      "max items stops fetching early",
+			options:   []ClientOption{WithMaxItems(3)},
+			wantIDs:   []string{"1", "2", "3"},
+			wantCalls: 2,
+		},
+		{
+			name:      "max items reached at the end of a page",
+			options:   []ClientOption{WithMaxItems(2)},
+			wantIDs:   []string{"1", "2"},
+			wantCalls: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			calls := 0
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				calls++
+				assert.Equal(t, "project-1", req.URL.Query().Get("project_id"))
+				assert.Equal(t, "2", req.URL.Query().Get("limit"))
+				return MockResponse(200, pages[req.URL.Query().Get("cursor")]), nil
+			})
+			for _, option := range append([]ClientOption{WithPageSize(2)}, tt.options...) {
+				option(client)
+			}
+
+			tasks, err := client.GetTasks(context.Background(), "project-1", "")
+			assert.NoError(t, err)
+
+			ids := make([]string, len(tasks))
+			for i, task := range tasks {
+				ids[i] = task.ID
+			}
+			assert.Equal(t, tt.wantIDs, ids)
+			assert.Equal(t, tt.wantCalls, calls)
+		})
+	}
+}
+
+func TestAllTasksIterator(t *testing.T) {
+	// Breaking out of the loop must not fetch further pages
+	calls := 0
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return MockResponse(200, PaginatedResponse[Task]{
+			Results:    []Task{{ID: "1"}, {ID: "2"}},
+			NextCursor: strPtr("next"),
+		}), nil
+	})
+
+	var ids []string
+	for task, err := range client.AllTasks(context.Background(), "", "") {
+		assert.NoError(t, err)
+		ids = append(ids, task.ID)
+		if len(ids) == 3 {
+			break
+		}
+	}
+
+	assert.Equal(t, []string{"1", "2", "1"}, ids)
+	assert.Equal(t, 2, calls)
+}
+
+func TestAllTasksIteratorError(t *testing.T) {
+	// An error on a later page is yielded after the earlier items
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") != "" {
+			return MockResponse(500, nil), nil
+		}
+		return MockResponse(200, PaginatedResponse[Task]{
+			Results:    []Task{*MockTask()},
+			NextCursor: strPtr("next"),
+		}), nil
+	})
+
+	var gotErr error
+	count := 0
+	for _, err := range client.AllTasks(context.Background(), "", "today") {
+		if err != nil {
+			gotErr = err
+			continue
+		}
+		count++
+	}
+
+	assert.Equal(t, 1, count)
+	assert.Error(t, gotErr)
+
+	_, err := client.GetTasks(context.Background(), "", "today")
+	assert.Error(t, err)
+}
+
+func TestGetCompletedTasks(t *testing.T) {
+	since := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	until := time.Date(2025, 1, 7, 23, 59, 59, 0, time.UTC)
+
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/tasks/completed/by_completion_date", req.URL.Path)
+		assert.Equal(t, "2025-01-01T00:00:00Z", req.URL.Query().Get("since"))
+		assert.Equal(t, "2025-01-07T23:59:59Z", req.URL.Query().Get("until"))
+		assert.Equal(t, "project-1", req.URL.Query().Get("project_id"))
+		assert.Equal(t, "c1", req.URL.Query().Get("cursor"))
+		return MockResponse(200, CompletedTasksPage{
+			Items:      []Task{{ID: "1", Checked: true, CompletedAt: strPtr("2025-01-03T10:00:00Z")}},
+			NextCursor: strPtr("c2"),
+		}), nil
+	})
+
+	page, err := client.GetCompletedTasks(context.Background(), CompletedTasksRequest{
+		Since:     since,
+		Until:     until,
+		ProjectID: "project-1",
+		Cursor:    "c1",
+	})
+	assert.NoError(t, err)
+	assert.Len(t, page.Items, 1)
+	assert.Equal(t, "2025-01-03T10:00:00Z", *page.Items[0].CompletedAt)
+	assert.Equal(t, "c2", *page.NextCursor)
+}
+
+func TestAllCompletedTasks(t *testing.T) {
+	// Completed tasks are paged under "items" rather than "results"
+	pages := map[string]CompletedTasksPage{
+		"":   {Items: []Task{{ID: "1"}, {ID: "2"}}, NextCursor: strPtr("c1")},
+		"c1": {Items: []Task{{ID: "3"}}, NextCursor: nil},
+	}
+
+	calls := 0
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		calls++
+		return MockResponse(200, pages[req.URL.Query().Get("cursor")]), nil
+	})
+
+	tasks, err := collect(client.AllCompletedTasks(context.Background(), CompletedTasksRequest{
+		Since: time.Now().Add(-time.Hour),
+		Until: time.Now(),
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 3)
+	assert.Equal(t, 2, calls)
+
+	// A cap reached at the end of a page fetches no further page
+	calls = 0
+	WithMaxItems(2)(client)
+	tasks, err = collect(client.AllCompletedTasks(context.Background(), CompletedTasksRequest{
+		Since: time.Now().Add(-time.Hour),
+		Until: time.Now(),
+	}))
+	assert.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, 1, calls)
+}
+
 func TestGetTask(t *testing.T) {
 	// モックタスクを取得
 	mockTask := MockTask()
@@ -232,6 +407,35 @@ func TestCreateTask(t *testing.T) {
 	}
 }
 
+func TestQuickAddTask(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/tasks/quick", req.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{
+			"text": "Call mom tomorrow 5pm #Family @phone p1",
+			"note": "Ask about the weekend",
+		}, body)
+
+		task := MockTask()
+		task.Content = "Call mom"
+		task.Labels = []string{"phone"}
+		task.Priority = 4
+		return MockResponse(200, task), nil
+	})
+
+	task, err := client.QuickAddTask(context.Background(), QuickAddTaskRequest{
+		Text: "Call mom tomorrow 5pm #Family @phone p1",
+		Note: "Ask about the weekend",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "Call mom", task.Content)
+	assert.Equal(t, []string{"phone"}, task.Labels)
+	assert.Equal(t, 4, task.Priority)
+}
+
 func TestUpdateTask(t *testing.T) {
 	// モックタスクを取得
 	mockTask := MockTask()
@@ -340,6 +544,51 @@ func TestCloseTask(t *testing.T) {
 	}
 }
 
+func TestReopenTask(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			id:      "123456789",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "api error",
+			id:      "123456789",
+			mockErr: errors.New("api error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				if tt.mockErr != nil {
+					return nil, tt.mockErr
+				}
+				assert.Equal(t, "/api/v1/tasks/"+tt.id+"/reopen", req.URL.Path)
+				return MockResponse(204, nil), nil
+			})
+
+			// Call the method
+			err := client.ReopenTask(context.Background(), tt.id)
+
+			// Check error
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
 func TestDeleteTask(t *testing.T) {
 	tests := []struct {
 		name    string
@@ -444,6 +693,23 @@ func TestGetProjects(t *testing.T) {
 	}
 }
 
+func TestGetProjectsPagination(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Query().Get("cursor") == "" {
+			return MockResponse(200, PaginatedResponse[Project]{
+				Results:    []Project{{ID: "1"}},
+				NextCursor: strPtr("next"),
+			}), nil
+		}
+		return MockResponse(200, MockPaginatedProjects([]Project{{ID: "2"}})), nil
+	})
+
+	projects, err := client.GetProjects(context.Background())
+	assert.NoError(t, err)
+	assert.Len(t, projects, 2)
+	assert.Equal(t, "2", projects[1].ID)
+}
+
 func TestGetProject(t *testing.T) {
 	// モックプロジェクトを取得
 	mockProject := MockProject()
@@ -500,3 +766,645 @@ func TestGetProject(t *testing.T) {
 		})
 	}
 }
+
+func TestGetLabels(t *testing.T) {
+	mockLabel := *MockLabel()
+
+	tests := []struct {
+		name       string
+		mockLabels []Label
+		mockErr    error
+		wantErr    bool
+	}{
+		{
+			name:       "success",
+			mockLabels: []Label{mockLabel, mockLabel},
+			mockErr:    nil,
+			wantErr:    false,
+		},
+		{
+			name:       "empty response",
+			mockLabels: []Label{},
+			mockErr:    nil,
+			wantErr:    false,
+		},
+		{
+			name:       "api error",
+			mockLabels: nil,
+			mockErr:    errors.New("api error"),
+			wantErr:    true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				if tt.mockErr != nil {
+					return nil, tt.mockErr
+				}
+				assert.Equal(t, "/api/v1/labels", req.URL.Path)
+				return MockResponse(200, PaginatedResponse[Label]{Results: tt.mockLabels}), nil
+			})
+
+			// Call the method
+			labels, err := client.GetLabels(context.Background())
+
+			// Check error
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.mockLabels), len(labels))
+				if len(tt.mockLabels) > 0 {
+					assert.Equal(t, tt.mockLabels[0].ID, labels[0].ID)
+					assert.Equal(t, tt.mockLabels[0].Name, labels[0].Name)
+				}
+			}
+		})
+	}
+}
+
+func TestGetLabel(t *testing.T) {
+	mockLabel := MockLabel()
+
+	tests := []struct {
+		name      string
+		id        string
+		mockLabel *Label
+		mockErr   error
+		wantErr   bool
+	}{
+		{
+			name:      "success",
+			id:        "2156154810",
+			mockLabel: mockLabel,
+			mockErr:   nil,
+			wantErr:   false,
+		},
+		{
+			name:      "not found",
+			id:        "2156154810",
+			mockLabel: nil,
+			mockErr:   nil,
+			wantErr:   true,
+		},
+		{
+			name:      "api error",
+			id:        "2156154810",
+			mockLabel: nil,
+			mockErr:   errors.New("api error"),
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				if tt.mockErr != nil {
+					return nil, tt.mockErr
+				}
+				assert.Equal(t, "/api/v1/labels/"+tt.id, req.URL.Path)
+				if tt.mockLabel == nil {
+					return MockResponse(404, nil), nil
+				}
+				return MockResponse(200, tt.mockLabel), nil
+			})
+
+			// Call the method
+			label, err := client.GetLabel(context.Background(), tt.id)
+
+			// Check error
+			if tt.wantErr {
+				assert.Error(t, err)
+				assert.Nil(t, label)
+			} else {
+				assert.NoError(t, err)
+				assert.NotNil(t, label)
+				assert.Equal(t, tt.mockLabel.ID, label.ID)
+				assert.Equal(t, tt.mockLabel.Name, label.Name)
+			}
+		})
+	}
+}
+
+func TestCreateLabel(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/labels", req.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "waiting", body["name"])
+		assert.Equal(t, "charcoal", body["color"])
+		assert.NotContains(t, body, "is_favorite")
+
+		return MockResponse(200, MockLabel()), nil
+	})
+
+	label, err := client.CreateLabel(context.Background(), CreateLabelRequest{
+		Name:  "waiting",
+		Color: "charcoal",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2156154810", label.ID)
+}
+
+func TestUpdateLabel(t *testing.T) {
+	// An explicit false favorite flag must be sent rather than omitted
+	isFavorite := false
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/labels/2156154810", req.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, false, body["is_favorite"])
+		assert.NotContains(t, body, "name")
+
+		return MockResponse(200, MockLabel()), nil
+	})
+
+	label, err := client.UpdateLabel(context.Background(), "2156154810", UpdateLabelRequest{
+		IsFavorite: &isFavorite,
+	})
+	assert.NoError(t, err)
+	assert.NotNil(t, label)
+
+	// API errors are returned to the caller
+	client = NewMockClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(400, nil), nil
+	})
+	label, err = client.UpdateLabel(context.Background(), "2156154810", UpdateLabelRequest{Name: "x"})
+	assert.Error(t, err)
+	assert.Nil(t, label)
+}
+
+func TestDeleteLabel(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      string
+		mockErr error
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			id:      "2156154810",
+			mockErr: nil,
+			wantErr: false,
+		},
+		{
+			name:    "api error",
+			id:      "2156154810",
+			mockErr: errors.New("api error"),
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				if tt.mockErr != nil {
+					return nil, tt.mockErr
+				}
+				assert.Equal(t, "DELETE", req.Method)
+				return MockResponse(204, nil), nil
+			})
+
+			// Call the method
+			err := client.DeleteLabel(context.Background(), tt.id)
+
+			// Check error
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRenameSharedLabel(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/labels/shared/rename", req.URL.Path)
+
+		var body RenameSharedLabelRequest
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "old", body.Name)
+		assert.Equal(t, "new", body.NewName)
+
+		return MockResponse(204, nil), nil
+	})
+
+	err := client.RenameSharedLabel(context.Background(), "old", "new")
+	assert.NoError(t, err)
+}
+
+func TestGetSections(t *testing.T) {
+	mockSection := *MockSection()
+
+	tests := []struct {
+		name         string
+		projectID    string
+		mockSections []Section
+		mockErr      error
+		wantErr      bool
+	}{
+		{
+			name:         "success",
+			projectID:    "987654321",
+			mockSections: []Section{mockSection, mockSection},
+			mockErr:      nil,
+			wantErr:      false,
+		},
+		{
+			name:         "all projects",
+			projectID:    "",
+			mockSections: []Section{mockSection},
+			mockErr:      nil,
+			wantErr:      false,
+		},
+		{
+			name:         "api error",
+			projectID:    "",
+			mockSections: nil,
+			mockErr:      errors.New("api error"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				if tt.mockErr != nil {
+					return nil, tt.mockErr
+				}
+				assert.Equal(t, "/api/v1/sections", req.URL.Path)
+				assert.Equal(t, tt.projectID, req.URL.Query().Get("project_id"))
+				return MockResponse(200, PaginatedResponse[Section]{Results: tt.mockSections}), nil
+			})
+
+			// Call the method
+			sections, err := client.GetSections(context.Background(), tt.projectID)
+
+			// Check error
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.mockSections), len(sections))
+				assert.Equal(t, tt.mockSections[0].Name, sections[0].Name)
+			}
+		})
+	}
+}
+
+func TestGetSection(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/sections/7025", req.URL.Path)
+		return MockResponse(200, MockSection()), nil
+	})
+
+	section, err := client.GetSection(context.Background(), "7025")
+	assert.NoError(t, err)
+	assert.Equal(t, "In Progress", section.Name)
+
+	client = NewMockClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(404, nil), nil
+	})
+	section, err = client.GetSection(context.Background(), "7025")
+	assert.Error(t, err)
+	assert.Nil(t, section)
+}
+
+func TestCreateSection(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/sections", req.URL.Path)
+
+		var body CreateSectionRequest
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, CreateSectionRequest{Name: "In Progress", ProjectID: "987654321", Order: intPtr(2)}, body)
+
+		return MockResponse(200, MockSection()), nil
+	})
+
+	section, err := client.CreateSection(context.Background(), CreateSectionRequest{
+		Name:      "In Progress",
+		ProjectID: "987654321",
+		Order:     intPtr(2),
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "7025", section.ID)
+}
+
+func TestUpdateSection(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/sections/7025", req.URL.Path)
+		return MockResponse(200, MockSection()), nil
+	})
+
+	section, err := client.UpdateSection(context.Background(), "7025", UpdateSectionRequest{Name: "Done"})
+	assert.NoError(t, err)
+	assert.NotNil(t, section)
+}
+
+func TestReorderSections(t *testing.T) {
+	tests := []struct {
+		name    string
+		status  func(uuid string) interface{}
+		wantErr bool
+	}{
+		{
+			name:    "success",
+			status:  func(string) interface{} { return "ok" },
+			wantErr: false,
+		},
+		{
+			name: "command error",
+			status: func(string) interface{} {
+				return map[string]interface{}{"error_code": 20, "error": "Section not found"}
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/api/v1/sync", req.URL.Path)
+				assert.Equal(t, "application/x-www-form-urlencoded", req.Header.Get("Content-Type"))
+				assert.NoError(t, req.ParseForm())
+
+				var commands []map[string]interface{}
+				assert.NoError(t, json.Unmarshal([]byte(req.PostForm.Get("commands")), &commands))
+				assert.Len(t, commands, 1)
+				assert.Equal(t, "section_reorder", commands[0]["type"])
+				uuid := commands[0]["uuid"].(string)
+				assert.NotEmpty(t, uuid)
+
+				return MockResponse(200, map[string]interface{}{
+					"sync_status": map[string]interface{}{uuid: tt.status(uuid)},
+				}), nil
+			})
+
+			err := client.ReorderSections(context.Background(), []SectionOrder{
+				{ID: "7025", SectionOrder: 2},
+				{ID: "7026", SectionOrder: 1},
+			})
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDeleteSection(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/v1/sections/7025", req.URL.Path)
+		return MockResponse(204, nil), nil
+	})
+
+	err := client.DeleteSection(context.Background(), "7025")
+	assert.NoError(t, err)
+}
+
+func TestMoveTask(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/tasks/123456789/move", req.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"section_id": "7025"}, body)
+
+		return MockResponse(200, MockTask()), nil
+	})
+
+	task, err := client.MoveTask(context.Background(), "123456789", MoveTaskRequest{SectionID: "7025"})
+	assert.NoError(t, err)
+	assert.Equal(t, "123456789", task.ID)
+}
+
+func TestGetComments(t *testing.T) {
+	mockComment := *MockComment()
+
+	tests := []struct {
+		name         string
+		taskID       string
+		projectID    string
+		mockComments []Comment
+		mockErr      error
+		wantErr      bool
+	}{
+		{
+			name:         "task comments",
+			taskID:       "123456789",
+			mockComments: []Comment{mockComment},
+			mockErr:      nil,
+			wantErr:      false,
+		},
+		{
+			name:         "project comments",
+			projectID:    "987654321",
+			mockComments: []Comment{mockComment, mockComment},
+			mockErr:      nil,
+			wantErr:      false,
+		},
+		{
+			name:         "api error",
+			taskID:       "123456789",
+			mockComments: nil,
+			mockErr:      errors.New("api error"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// Create mock client
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				if tt.mockErr != nil {
+					return nil, tt.mockErr
+				}
+				assert.Equal(t, "/api/v1/comments", req.URL.Path)
+				assert.Equal(t, tt.taskID, req.URL.Query().Get("task_id"))
+				assert.Equal(t, tt.projectID, req.URL.Query().Get("project_id"))
+				return MockResponse(200, PaginatedResponse[Comment]{Results: tt.mockComments}), nil
+			})
+
+			// Call the method
+			comments, err := client.GetComments(context.Background(), tt.taskID, tt.projectID)
+
+			// Check error
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, len(tt.mockComments), len(comments))
+				assert.Equal(t, "application/pdf", comments[0].FileAttachment.FileType)
+				assert.Equal(t, "File.pdf", comments[0].FileAttachment.FileName)
+			}
+		})
+	}
+}
+
+func TestCreateComment(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/comments", req.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, "123456789", body["task_id"])
+		assert.NotContains(t, body, "project_id")
+		attachment, ok := body["attachment"].(map[string]interface{})
+		assert.True(t, ok)
+		assert.Equal(t, "https://example.com/a.pdf", attachment["file_url"])
+
+		return MockResponse(200, MockComment()), nil
+	})
+
+	comment, err := client.CreateComment(context.Background(), CreateCommentRequest{
+		Content: "See attached",
+		TaskID:  "123456789",
+		Attachment: &FileAttachment{
+			FileURL: "https://example.com/a.pdf",
+		},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "2992679862", comment.ID)
+}
+
+func TestUpdateComment(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/comments/2992679862", req.URL.Path)
+		return MockResponse(200, MockComment()), nil
+	})
+
+	comment, err := client.UpdateComment(context.Background(), "2992679862", UpdateCommentRequest{Content: "Edited"})
+	assert.NoError(t, err)
+	assert.NotNil(t, comment)
+}
+
+func TestDeleteComment(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/v1/comments/2992679862", req.URL.Path)
+		return MockResponse(204, nil), nil
+	})
+
+	err := client.DeleteComment(context.Background(), "2992679862")
+	assert.NoError(t, err)
+}
+
+func TestCreateProject(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/projects", req.URL.Path)
+
+		var body CreateProjectRequest
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, CreateProjectRequest{
+			Name:       "Clients",
+			ParentID:   "987654321",
+			Color:      "blue",
+			IsFavorite: true,
+			ViewStyle:  "board",
+		}, body)
+
+		return MockResponse(200, MockProject()), nil
+	})
+
+	project, err := client.CreateProject(context.Background(), CreateProjectRequest{
+		Name:       "Clients",
+		ParentID:   "987654321",
+		Color:      "blue",
+		IsFavorite: true,
+		ViewStyle:  "board",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "987654321", project.ID)
+}
+
+func TestUpdateProject(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "POST", req.Method)
+		assert.Equal(t, "/api/v1/projects/987654321", req.URL.Path)
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		assert.Equal(t, map[string]interface{}{"name": "Renamed"}, body)
+
+		return MockResponse(200, MockProject()), nil
+	})
+
+	project, err := client.UpdateProject(context.Background(), "987654321", UpdateProjectRequest{Name: "Renamed"})
+	assert.NoError(t, err)
+	assert.NotNil(t, project)
+}
+
+func TestArchiveProject(t *testing.T) {
+	tests := []struct {
+		name     string
+		call     func(c *Client) (*Project, error)
+		wantPath string
+	}{
+		{
+			name: "archive",
+			call: func(c *Client) (*Project, error) {
+				return c.ArchiveProject(context.Background(), "987654321")
+			},
+			wantPath: "/api/v1/projects/987654321/archive",
+		},
+		{
+			name: "unarchive",
+			call: func(c *Client) (*Project, error) {
+				return c.UnarchiveProject(context.Background(), "987654321")
+			},
+			wantPath: "/api/v1/projects/987654321/unarchive",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "POST", req.Method)
+				assert.Equal(t, tt.wantPath, req.URL.Path)
+				return MockResponse(200, MockProject()), nil
+			})
+
+			project, err := tt.call(client)
+			assert.NoError(t, err)
+			assert.Equal(t, "987654321", project.ID)
+
+			// API errors are returned to the caller
+			client = NewMockClient(func(req *http.Request) (*http.Response, error) {
+				return MockResponse(404, nil), nil
+			})
+			project, err = tt.call(client)
+			assert.Error(t, err)
+			assert.Nil(t, project)
+		})
+	}
+}
+
+func TestDeleteProject(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		assert.Equal(t, "/api/v1/projects/987654321", req.URL.Path)
+		return MockResponse(204, nil), nil
+	})
+
+	err := client.DeleteProject(context.Background(), "987654321")
+	assert.NoError(t, err)
+}
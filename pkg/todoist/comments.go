@@ -0,0 +1,411 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetCommentsResponse represents the response from the todoist_get_comments tool
+type GetCommentsResponse struct {
+	Comments []Comment `json:"comments"`
+}
+
+// AddCommentResponse represents the response from the todoist_add_comment tool
+type AddCommentResponse struct {
+	Comment Comment `json:"comment"`
+}
+
+// UpdateCommentResponse represents the response from the todoist_update_comment tool
+type UpdateCommentResponse struct {
+	Comment Comment `json:"comment"`
+}
+
+// GetComments returns the todoist_get_comments tool
+func (tp *ToolProvider) GetComments() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"taskId": map[string]interface{}{
+				"type":        "string",
+				"description": "Task ID whose comments to retrieve. Exactly one of taskId or projectId must be specified.",
+			},
+			"projectId": map[string]interface{}{
+				"type":        "string",
+				"description": "Project ID whose comments to retrieve. Exactly one of taskId or projectId must be specified.",
+			},
+		},
+	}
+
+	addFormatProperties(inputSchema, commentRenderer)
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetCommentsResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_get_comments",
+		Description:  "Get all comments of a task or project, including file attachment metadata.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetComments handles the todoist_get_comments tool request
+func (tp *ToolProvider) HandleGetComments(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, commentRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	taskID, err := OptionalParam[string](request, "taskId")
+	if err != nil {
+		return newToolResultError("Invalid parameter: taskId", err), nil
+	}
+	projectID, err := OptionalParam[string](request, "projectId")
+	if err != nil {
+		return newToolResultError("Invalid parameter: projectId", err), nil
+	}
+	if err := validateCommentTarget(taskID, projectID); err != nil {
+		return newToolResultError("Invalid parameters", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"taskId":    taskID,
+		"projectId": projectID,
+	}).Info("Getting comments")
+
+	// Call the Todoist API
+	comments, err := tp.client.GetComments(ctx, taskID, projectID)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get comments")
+		return newToolResultError("Failed to get comments", err), nil
+	}
+
+	// Convert comments to JSON
+	response := GetCommentsResponse{
+		Comments: comments,
+	}
+
+	// Return the response in the requested format
+	return renderList(format, commentRenderer, response, "comments", comments, nil), nil
+}
+
+// AddComment returns the todoist_add_comment tool
+func (tp *ToolProvider) AddComment() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"content"},
+		"properties": map[string]interface{}{
+			"content": map[string]interface{}{
+				"type":        "string",
+				"description": "The text of the comment (required). Supports Markdown formatting.",
+			},
+			"taskId": map[string]interface{}{
+				"type":        "string",
+				"description": "Task ID to comment on. Exactly one of taskId or projectId must be specified.",
+			},
+			"projectId": map[string]interface{}{
+				"type":        "string",
+				"description": "Project ID to comment on. Exactly one of taskId or projectId must be specified.",
+			},
+			"attachment": map[string]interface{}{
+				"type":        "object",
+				"description": "A file or link to attach to the comment. The file must already be hosted at fileUrl.",
+				"required":    []string{"fileUrl"},
+				"properties": map[string]interface{}{
+					"fileName": map[string]interface{}{
+						"type":        "string",
+						"description": "The name of the attached file.",
+					},
+					"fileType": map[string]interface{}{
+						"type":        "string",
+						"description": "The MIME type of the attached file, e.g., 'application/pdf'.",
+					},
+					"fileUrl": map[string]interface{}{
+						"type":        "string",
+						"description": "The URL of the attached file (required).",
+					},
+					"resourceType": map[string]interface{}{
+						"type":        "string",
+						"description": "The type of the attachment, e.g., 'file' or 'url'.",
+					},
+				},
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[AddCommentResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_add_comment",
+		Description:  "Add a comment to a task or project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleAddComment handles the todoist_add_comment tool request
+func (tp *ToolProvider) HandleAddComment(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	content, err := RequiredParam[string](request, "content")
+	if err != nil {
+		return newToolResultError("Missing required parameter: content", err), nil
+	}
+
+	taskID, err := OptionalParam[string](request, "taskId")
+	if err != nil {
+		return newToolResultError("Invalid parameter: taskId", err), nil
+	}
+	projectID, err := OptionalParam[string](request, "projectId")
+	if err != nil {
+		return newToolResultError("Invalid parameter: projectId", err), nil
+	}
+	if err := validateCommentTarget(taskID, projectID); err != nil {
+		return newToolResultError("Invalid parameters", err), nil
+	}
+
+	attachment, err := fileAttachmentParam(request, "attachment")
+	if err != nil {
+		return newToolResultError("Invalid parameter: attachment", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"taskId":        taskID,
+		"projectId":     projectID,
+		"hasAttachment": attachment != nil,
+	}).Info("Adding comment")
+
+	// Create request
+	createReq := CreateCommentRequest{
+		Content:    content,
+		TaskID:     taskID,
+		ProjectID:  projectID,
+		Attachment: attachment,
+	}
+
+	// Call the Todoist API
+	comment, err := tp.client.CreateComment(ctx, createReq)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to add comment")
+		return newToolResultError("Failed to add comment", err), nil
+	}
+
+	// Convert comment to JSON
+	response := AddCommentResponse{
+		Comment: *comment,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// UpdateComment returns the todoist_update_comment tool
+func (tp *ToolProvider) UpdateComment() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id", "content"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the comment to update (required). Specify the numeric Todoist comment ID (e.g., '2992679862').",
+			},
+			"content": map[string]interface{}{
+				"type":        "string",
+				"description": "The new text of the comment (required). Supports Markdown formatting.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[UpdateCommentResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_update_comment",
+		Description:  "Update the text of an existing comment.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleUpdateComment handles the todoist_update_comment tool request
+func (tp *ToolProvider) HandleUpdateComment(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	content, err := RequiredParam[string](request, "content")
+	if err != nil {
+		return newToolResultError("Missing required parameter: content", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Updating comment")
+
+	// Call the Todoist API
+	comment, err := tp.client.UpdateComment(ctx, id, UpdateCommentRequest{Content: content})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to update comment")
+		return newToolResultError("Failed to update comment", err), nil
+	}
+
+	// Convert comment to JSON
+	response := UpdateCommentResponse{
+		Comment: *comment,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// DeleteComment returns the todoist_delete_comment tool
+func (tp *ToolProvider) DeleteComment() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the comment to delete (required). Specify the numeric Todoist comment ID (e.g., '2992679862'). Warning: This action is permanent and cannot be undone.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_delete_comment",
+		Description:  "Delete a comment.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleDeleteComment handles the todoist_delete_comment tool request
+func (tp *ToolProvider) HandleDeleteComment(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Deleting comment")
+
+	// Call the Todoist API
+	err = tp.client.DeleteComment(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to delete comment")
+		return newToolResultError("Failed to delete comment", err), nil
+	}
+
+	// Return success response
+	return jsonResult(SuccessResponse{Success: true}), nil
+}
+
+// validateCommentTarget checks that a comment refers to exactly one task or project
+func validateCommentTarget(taskID, projectID string) error {
+	if taskID == "" && projectID == "" {
+		return errors.New("one of taskId or projectId is required")
+	}
+	if taskID != "" && projectID != "" {
+		return errors.New("only one of taskId or projectId may be specified")
+	}
+	return nil
+}
+
+// fileAttachmentParam parses an optional attachment object from the request.
+// It returns nil if the parameter is not present.
+func fileAttachmentParam(r *mcp.CallToolRequest, p string) (*FileAttachment, error) {
+	args, err := getArguments(r)
+	if err != nil {
+		return nil, err
+	}
+
+	raw, ok := args[p]
+	if !ok || raw == nil {
+		return nil, nil
+	}
+
+	obj, ok := raw.(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("parameter %s is not an object", p)
+	}
+
+	fields := map[string]string{}
+	for _, key := range []string{"fileName", "fileType", "fileUrl", "resourceType"} {
+		if v, present := obj[key]; present {
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("parameter %s.%s is not a string", p, key)
+			}
+			fields[key] = s
+		}
+	}
+	if fields["fileUrl"] == "" {
+		return nil, fmt.Errorf("parameter %s.fileUrl is required", p)
+	}
+
+	return &FileAttachment{
+		FileName:     fields["fileName"],
+		FileType:     fields["fileType"],
+		FileURL:      fields["fileUrl"],
+		ResourceType: fields["resourceType"],
+	}, nil
+}
@@ -0,0 +1,101 @@
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// GetComments retrieves all comments of a task or project, following next_cursor across pages.
+// Exactly one of taskID and projectID must be provided.
+func (c *Client) GetComments(ctx context.Context, taskID, projectID string) ([]Comment, error) {
+	return collect(c.AllComments(ctx, taskID, projectID))
+}
+
+// AllComments returns an iterator over the comments of a task or project that fetches pages lazily
+func (c *Client) AllComments(ctx context.Context, taskID, projectID string) iter.Seq2[Comment, error] {
+	params := url.Values{}
+	if taskID != "" {
+		params.Set("task_id", taskID)
+	}
+	if projectID != "" {
+		params.Set("project_id", projectID)
+	}
+
+	return paginate[Comment](ctx, c, "/comments", params, "get comments")
+}
+
+// CreateComment adds a comment to a task or project
+func (c *Client) CreateComment(ctx context.Context, req CreateCommentRequest) (*Comment, error) {
+	endpoint := "/comments"
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create comment: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var comment Comment
+	if err := json.Unmarshal(bodyBytes, &comment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// UpdateComment updates the content of an existing comment
+func (c *Client) UpdateComment(ctx context.Context, id string, req UpdateCommentRequest) (*Comment, error) {
+	endpoint := fmt.Sprintf("/comments/%s", id)
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update comment: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var comment Comment
+	if err := json.Unmarshal(bodyBytes, &comment); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &comment, nil
+}
+
+// DeleteComment deletes a comment
+func (c *Client) DeleteComment(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/comments/%s", id)
+
+	resp, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+
+	_, err = c.processResponse(resp, http.StatusNoContent)
+	return err
+}
@@ -0,0 +1,162 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetCommentsTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.GetComments()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_get_comments", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	// Check input schema
+	var schema map[string]interface{}
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+	err = json.Unmarshal(schemaBytes, &schema)
+	assert.NoError(t, err)
+
+	properties, ok := schema["properties"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, properties, "taskId")
+	assert.Contains(t, properties, "projectId")
+}
+
+func TestHandleGetComments(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, PaginatedResponse[Comment]{Results: []Comment{*MockComment()}}), nil
+	})
+
+	tests := []struct {
+		name      string
+		params    map[string]interface{}
+		wantError bool
+	}{
+		{
+			name:      "task comments",
+			params:    map[string]interface{}{"taskId": "123456789"},
+			wantError: false,
+		},
+		{
+			name:      "project comments",
+			params:    map[string]interface{}{"projectId": "987654321"},
+			wantError: false,
+		},
+		{
+			name:      "no target",
+			params:    map[string]interface{}{},
+			wantError: true,
+		},
+		{
+			name:      "both targets",
+			params:    map[string]interface{}{"taskId": "123456789", "projectId": "987654321"},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tp.HandleGetComments(context.Background(), MockCallToolRequest(tt.params))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantError, result.IsError)
+
+			if !tt.wantError {
+				// Attachment metadata is preserved in the tool output
+				var response GetCommentsResponse
+				assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+				assert.Len(t, response.Comments, 1)
+				assert.Equal(t, "File.pdf", response.Comments[0].FileAttachment.FileName)
+				assert.Equal(t, "application/pdf", response.Comments[0].FileAttachment.FileType)
+				assert.Equal(t, "https://cdn-domain.tld/path/to/file.pdf", response.Comments[0].FileAttachment.FileURL)
+			}
+		})
+	}
+}
+
+func TestHandleAddComment(t *testing.T) {
+	var body CreateCommentRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		body = CreateCommentRequest{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockComment()), nil
+	})
+
+	// Comment with attachment
+	result, err := tp.HandleAddComment(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"content": "See attached",
+		"taskId":  "123456789",
+		"attachment": map[string]interface{}{
+			"fileName": "File.pdf",
+			"fileType": "application/pdf",
+			"fileUrl":  "https://example.com/File.pdf",
+		},
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "123456789", body.TaskID)
+	assert.Equal(t, &FileAttachment{
+		FileName: "File.pdf",
+		FileType: "application/pdf",
+		FileURL:  "https://example.com/File.pdf",
+	}, body.Attachment)
+
+	// Comment without attachment
+	result, err = tp.HandleAddComment(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"content":   "Kick-off notes",
+		"projectId": "987654321",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Nil(t, body.Attachment)
+
+	// Attachment without URL is rejected
+	result, err = tp.HandleAddComment(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"content":    "See attached",
+		"taskId":     "123456789",
+		"attachment": map[string]interface{}{"fileName": "File.pdf"},
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestHandleUpdateComment(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, MockComment()), nil
+	})
+
+	result, err := tp.HandleUpdateComment(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id": "2992679862",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	result, err = tp.HandleUpdateComment(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":      "2992679862",
+		"content": "Edited",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
+func TestHandleDeleteComment(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(204, nil), nil
+	})
+
+	result, err := tp.HandleDeleteComment(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id": "2992679862",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+}
@@ -0,0 +1,270 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
+	"github.com/sirupsen/logrus"
+)
+
+// ToolsetInfo describes a toolset in the response of the list_available_toolsets tool
+type ToolsetInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	Enabled     bool   `json:"enabled"`
+	Tools       int    `json:"tools" jsonschema:"The number of tools in the toolset"`
+}
+
+// ListAvailableToolsetsParams represents the parameters for the list_available_toolsets tool
+type ListAvailableToolsetsParams struct{}
+
+// ListAvailableToolsetsResponse represents the response from the list_available_toolsets tool
+type ListAvailableToolsetsResponse struct {
+	Toolsets []ToolsetInfo `json:"toolsets"`
+}
+
+// ToolInfo describes a tool in the response of the get_toolset_tools tool
+type ToolInfo struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	ReadOnly    bool   `json:"readOnly" jsonschema:"Whether the tool only reads data"`
+}
+
+// GetToolsetToolsParams represents the parameters for the get_toolset_tools tool
+type GetToolsetToolsParams struct {
+	Toolset string `json:"toolset" jsonschema:"The name of the toolset whose tools to list (required), as returned by list_available_toolsets."`
+}
+
+// GetToolsetToolsResponse represents the response from the get_toolset_tools tool
+type GetToolsetToolsResponse struct {
+	Toolset string     `json:"toolset"`
+	Enabled bool       `json:"enabled"`
+	Tools   []ToolInfo `json:"tools"`
+}
+
+// EnableToolsetParams represents the parameters for the enable_toolset tool
+type EnableToolsetParams struct {
+	Toolset string `json:"toolset" jsonschema:"The name of the toolset to enable (required), as returned by list_available_toolsets."`
+}
+
+// EnableToolsetResponse represents the response from the enable_toolset tool
+type EnableToolsetResponse struct {
+	Toolset        string   `json:"toolset"`
+	AlreadyEnabled bool     `json:"alreadyEnabled" jsonschema:"Whether the toolset was enabled before the call"`
+	Tools          []string `json:"tools" jsonschema:"The names of the tools of the toolset, which are now available"`
+}
+
+// dynamicToolsets provides the meta-tools that list the toolsets of a group and enable them
+// on a running server, so that a session starts with few tools and adds the ones it needs.
+// The server notifies clients of the added tools with tools/list_changed.
+type dynamicToolsets struct {
+	server *mcp.Server
+	group  *toolsets.ToolsetGroup
+	logger *logrus.Logger
+	// mu guards the enabled state of the toolsets, which all sessions share
+	mu sync.Mutex
+}
+
+// newDynamicToolsets creates the meta-tools for the toolsets of group, which register the
+// toolsets they enable on server
+func newDynamicToolsets(server *mcp.Server, group *toolsets.ToolsetGroup, logger *logrus.Logger) *dynamicToolsets {
+	return &dynamicToolsets{
+		server: server,
+		group:  group,
+		logger: logger,
+	}
+}
+
+// Toolset returns the enabled toolset of the meta-tools, which is not part of the group
+func (d *dynamicToolsets) Toolset() *toolsets.Toolset {
+	toolset := toolsets.NewToolset("dynamic", "Discover and enable further Todoist toolsets")
+	toolset.AddReadTools(
+		toolsets.NewTypedServerTool(d.ListAvailableToolsets(), d.HandleListAvailableToolsets),
+		toolsets.NewTypedServerTool(d.GetToolsetTools(), d.HandleGetToolsetTools),
+	)
+	toolset.AddWriteTools(
+		toolsets.NewTypedServerTool(d.EnableToolset(), d.HandleEnableToolset),
+	)
+	toolset.Enabled = true
+	return toolset
+}
+
+// toolsetNames returns the names of the toolsets that have tools, which are the ones that
+// can be listed and enabled. In read-only mode some toolsets have none.
+func (d *dynamicToolsets) toolsetNames() []string {
+	var names []string
+	for _, name := range d.group.ToolsetNames() {
+		if len(d.group.Toolsets[name].GetAvailableTools()) > 0 {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// toolset returns the named toolset, or an error listing the available ones
+func (d *dynamicToolsets) toolset(name string) (*toolsets.Toolset, error) {
+	names := d.toolsetNames()
+	for _, n := range names {
+		if n == name {
+			return d.group.Toolsets[name], nil
+		}
+	}
+	return nil, fmt.Errorf("toolset %q does not exist (available: %s)", name, strings.Join(names, ", "))
+}
+
+// ListAvailableToolsets returns the list_available_toolsets tool
+func (d *dynamicToolsets) ListAvailableToolsets() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[ListAvailableToolsetsParams](nil)
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[ListAvailableToolsetsResponse]()
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "list_available_toolsets",
+		Description:  "List the Todoist toolsets that can be enabled, and whether they are enabled. Call this first when the tools for a task, such as labels or comments, are missing.",
+		InputSchema:  inputSchema,
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleListAvailableToolsets handles the list_available_toolsets tool request
+func (d *dynamicToolsets) HandleListAvailableToolsets(ctx context.Context, request *mcp.CallToolRequest, params ListAvailableToolsetsParams) (*mcp.CallToolResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	response := ListAvailableToolsetsResponse{Toolsets: []ToolsetInfo{}}
+	for _, name := range d.toolsetNames() {
+		toolset := d.group.Toolsets[name]
+		response.Toolsets = append(response.Toolsets, ToolsetInfo{
+			Name:        toolset.Name,
+			Description: toolset.Description,
+			Enabled:     toolset.Enabled,
+			Tools:       len(toolset.GetAvailableTools()),
+		})
+	}
+	return jsonResult(response), nil
+}
+
+// GetToolsetTools returns the get_toolset_tools tool
+func (d *dynamicToolsets) GetToolsetTools() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[GetToolsetToolsParams](map[string]map[string]interface{}{
+		"toolset": {"enum": d.toolsetNames()},
+	})
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetToolsetToolsResponse]()
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "get_toolset_tools",
+		Description:  "List the tools of a Todoist toolset, to decide whether to enable it.",
+		InputSchema:  inputSchema,
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetToolsetTools handles the get_toolset_tools tool request
+func (d *dynamicToolsets) HandleGetToolsetTools(ctx context.Context, request *mcp.CallToolRequest, params GetToolsetToolsParams) (*mcp.CallToolResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	toolset, err := d.toolset(params.Toolset)
+	if err != nil {
+		return newToolResultError("Invalid parameter: toolset", err), nil
+	}
+
+	response := GetToolsetToolsResponse{
+		Toolset: toolset.Name,
+		Enabled: toolset.Enabled,
+		Tools:   []ToolInfo{},
+	}
+	for _, tool := range toolset.GetAvailableTools() {
+		response.Tools = append(response.Tools, ToolInfo{
+			Name:        tool.Tool.Name,
+			Description: tool.Tool.Description,
+			ReadOnly:    tool.Tool.Annotations != nil && tool.Tool.Annotations.ReadOnlyHint,
+		})
+	}
+	return jsonResult(response), nil
+}
+
+// EnableToolset returns the enable_toolset tool
+func (d *dynamicToolsets) EnableToolset() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[EnableToolsetParams](map[string]map[string]interface{}{
+		"toolset": {"enum": d.toolsetNames()},
+	})
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[EnableToolsetResponse]()
+	if err != nil {
+		d.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "enable_toolset",
+		Description:  "Enable a Todoist toolset, making its tools available for the rest of the session. The tool list of the client is updated through a tools/list_changed notification.",
+		InputSchema:  inputSchema,
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{IdempotentHint: true},
+	}
+}
+
+// HandleEnableToolset handles the enable_toolset tool request
+func (d *dynamicToolsets) HandleEnableToolset(ctx context.Context, request *mcp.CallToolRequest, params EnableToolsetParams) (*mcp.CallToolResult, error) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	toolset, err := d.toolset(params.Toolset)
+	if err != nil {
+		return newToolResultError("Invalid parameter: toolset", err), nil
+	}
+
+	response := EnableToolsetResponse{
+		Toolset:        toolset.Name,
+		AlreadyEnabled: toolset.Enabled,
+		Tools:          []string{},
+	}
+	for _, tool := range toolset.GetAvailableTools() {
+		response.Tools = append(response.Tools, tool.Tool.Name)
+	}
+
+	if !toolset.Enabled {
+		if err := d.group.EnableToolset(toolset.Name); err != nil {
+			return newToolResultError("Failed to enable toolset", err), nil
+		}
+		// Adding the tools to the running server notifies the connected clients
+		toolset.RegisterTools(d.server)
+		d.logger.WithField("toolset", toolset.Name).Info("Enabled toolset")
+	}
+
+	return jsonResult(response), nil
+}
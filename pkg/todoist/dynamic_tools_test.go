@@ -0,0 +1,155 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// connectDynamicServer starts a server with dynamic toolsets and the given toolsets enabled,
+// and connects a client that reports tools/list_changed notifications on the returned channel
+func connectDynamicServer(t *testing.T, names []string, readOnly bool) (*mcp.ClientSession, <-chan struct{}) {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	server := NewServer("test-token", logger)
+	server.EnableDynamicToolsets()
+	require.NoError(t, server.ConfigureToolsets(names, readOnly))
+	server.registerTools()
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := server.mcpServer.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, serverSession.Close()) })
+
+	changed := make(chan struct{}, 10)
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.1"}, &mcp.ClientOptions{
+		ToolListChangedHandler: func(context.Context, *mcp.ToolListChangedRequest) {
+			changed <- struct{}{}
+		},
+	})
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, session.Close()) })
+	return session, changed
+}
+
+// listToolNames returns the names of the tools the session can call
+func listToolNames(t *testing.T, session *mcp.ClientSession) []string {
+	result, err := session.ListTools(context.Background(), nil)
+	require.NoError(t, err)
+	names := make([]string, len(result.Tools))
+	for i, tool := range result.Tools {
+		names[i] = tool.Name
+	}
+	return names
+}
+
+// callDynamicTool calls a tool and decodes its structured content into response
+func callDynamicTool(t *testing.T, session *mcp.ClientSession, name string, args map[string]interface{}, response interface{}) {
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{Name: name, Arguments: args})
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	require.NoError(t, remarshal(result.StructuredContent, response))
+}
+
+func TestDynamicToolsets(t *testing.T) {
+	session, changed := connectDynamicServer(t, nil, false)
+
+	// Only the meta-tools are registered at first
+	assert.ElementsMatch(t, []string{"list_available_toolsets", "get_toolset_tools", "enable_toolset"}, listToolNames(t, session))
+
+	var toolsetList ListAvailableToolsetsResponse
+	callDynamicTool(t, session, "list_available_toolsets", nil, &toolsetList)
+	require.Len(t, toolsetList.Toolsets, 6)
+	assert.Equal(t, ToolsetInfo{Name: "labels", Description: "Todoist label management tools", Enabled: false, Tools: 6}, toolsetList.Toolsets[2])
+
+	var toolList GetToolsetToolsResponse
+	callDynamicTool(t, session, "get_toolset_tools", map[string]interface{}{"toolset": "labels"}, &toolList)
+	assert.Equal(t, "labels", toolList.Toolset)
+	assert.False(t, toolList.Enabled)
+	require.Len(t, toolList.Tools, 6)
+	assert.Equal(t, "todoist_get_labels", toolList.Tools[0].Name)
+	assert.True(t, toolList.Tools[0].ReadOnly)
+	assert.False(t, toolList.Tools[2].ReadOnly)
+
+	// Enabling a toolset registers its tools and notifies the client
+	var enabled EnableToolsetResponse
+	callDynamicTool(t, session, "enable_toolset", map[string]interface{}{"toolset": "labels"}, &enabled)
+	assert.False(t, enabled.AlreadyEnabled)
+	assert.Contains(t, enabled.Tools, "todoist_create_label")
+	select {
+	case <-changed:
+	case <-time.After(5 * time.Second):
+		t.Fatal("no tools/list_changed notification")
+	}
+	names := listToolNames(t, session)
+	assert.Len(t, names, 9)
+	assert.Contains(t, names, "todoist_get_labels")
+	assert.Contains(t, names, "todoist_rename_shared_label")
+
+	callDynamicTool(t, session, "list_available_toolsets", nil, &toolsetList)
+	assert.True(t, toolsetList.Toolsets[2].Enabled)
+
+	// Enabling it again changes nothing
+	callDynamicTool(t, session, "enable_toolset", map[string]interface{}{"toolset": "labels"}, &enabled)
+	assert.True(t, enabled.AlreadyEnabled)
+	assert.Len(t, listToolNames(t, session), 9)
+
+	// Unknown toolsets are rejected by the input schema
+	result, err := session.CallTool(context.Background(), &mcp.CallToolParams{
+		Name:      "enable_toolset",
+		Arguments: map[string]interface{}{"toolset": "filters"},
+	})
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "/properties/toolset: enum")
+}
+
+func TestDynamicToolsetsReadOnly(t *testing.T) {
+	session, _ := connectDynamicServer(t, []string{"projects"}, true)
+
+	names := listToolNames(t, session)
+	assert.Contains(t, names, "todoist_get_projects")
+	assert.NotContains(t, names, "todoist_create_project")
+
+	// The batch toolset has no read tools, so it cannot be enabled
+	var toolsetList ListAvailableToolsetsResponse
+	callDynamicTool(t, session, "list_available_toolsets", nil, &toolsetList)
+	var toolsetNames []string
+	for _, toolset := range toolsetList.Toolsets {
+		toolsetNames = append(toolsetNames, toolset.Name)
+	}
+	assert.Equal(t, []string{"comments", "labels", "projects", "sections", "tasks"}, toolsetNames)
+	assert.True(t, toolsetList.Toolsets[2].Enabled)
+
+	// Only the read tools of an enabled toolset are registered
+	var enabled EnableToolsetResponse
+	callDynamicTool(t, session, "enable_toolset", map[string]interface{}{"toolset": "tasks"}, &enabled)
+	assert.ElementsMatch(t, []string{"todoist_get_task_filter_rules", "todoist_get_tasks", "todoist_get_task", "todoist_get_completed_tasks"}, enabled.Tools)
+	names = listToolNames(t, session)
+	assert.Contains(t, names, "todoist_get_tasks")
+	assert.NotContains(t, names, "todoist_create_task")
+}
+
+func TestHandleEnableToolsetUnknown(t *testing.T) {
+	server := NewServer("test-token", logrus.New())
+	dynamic := newDynamicToolsets(server.mcpServer, server.toolsetGroup, server.logger)
+
+	result, err := CallTypedTool(context.Background(), dynamic.HandleEnableToolset, MockCallToolRequest(map[string]interface{}{"toolset": "filters"}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), `toolset "filters" does not exist (available: batch, comments, labels, projects, sections, tasks)`)
+
+	// The output schema describes the structured content
+	var schema map[string]interface{}
+	require.NoError(t, json.Unmarshal(dynamic.EnableToolset().OutputSchema.(json.RawMessage), &schema))
+	assert.Contains(t, schema["properties"], "alreadyEnabled")
+}
@@ -0,0 +1,172 @@
+package todoist
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// APIError is returned when the Todoist API responds with an unexpected status
+type APIError struct {
+	// StatusCode is the HTTP status of the response
+	StatusCode int
+	// ErrorCode is the numeric Todoist error code, if the response included one
+	ErrorCode int
+	// ErrorTag is the Todoist error tag, e.g. "NOT_FOUND", if the response included one
+	ErrorTag string
+	// Message is the error message from the response, or the raw body if it was not JSON
+	Message string
+	// RequestID identifies the request for Todoist support, if known
+	RequestID string
+	// RetryAfter is how long Todoist asked to wait before retrying, or zero
+	RetryAfter time.Duration
+}
+
+// Error implements the error interface
+func (e *APIError) Error() string {
+	msg := fmt.Sprintf("API request failed with status %d", e.StatusCode)
+	if e.Message != "" {
+		msg += ": " + e.Message
+	}
+
+	var details []string
+	if e.ErrorTag != "" {
+		details = append(details, "tag "+e.ErrorTag)
+	}
+	if e.ErrorCode != 0 {
+		details = append(details, fmt.Sprintf("code %d", e.ErrorCode))
+	}
+	if e.RequestID != "" {
+		details = append(details, "request id "+e.RequestID)
+	}
+	if len(details) > 0 {
+		msg += " (" + strings.Join(details, ", ") + ")"
+	}
+	return msg
+}
+
+// newAPIError builds an APIError from a failed response and its body
+func newAPIError(resp *http.Response, body []byte) *APIError {
+	apiErr := &APIError{
+		StatusCode: resp.StatusCode,
+		Message:    strings.TrimSpace(string(body)),
+		RequestID:  resp.Header.Get(idempotencyKeyHeader),
+	}
+	if apiErr.RequestID == "" && resp.Request != nil {
+		apiErr.RequestID = resp.Request.Header.Get(idempotencyKeyHeader)
+	}
+	if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		apiErr.RetryAfter = delay
+	}
+
+	// Todoist describes most errors with a JSON body
+	var errResp ErrorResponse
+	if err := json.Unmarshal(body, &errResp); err == nil && errResp.Error != "" {
+		apiErr.Message = errResp.Error
+		apiErr.ErrorCode = errResp.ErrorCode
+		apiErr.ErrorTag = errResp.ErrorTag
+	}
+
+	return apiErr
+}
+
+// hasStatus reports whether err is an APIError with one of the given statuses
+func hasStatus(err error, statuses ...int) bool {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	for _, status := range statuses {
+		if apiErr.StatusCode == status {
+			return true
+		}
+	}
+	return false
+}
+
+// IsNotFound reports whether err is an APIError for a resource that does not exist
+func IsNotFound(err error) bool {
+	return hasStatus(err, http.StatusNotFound)
+}
+
+// IsUnauthorized reports whether err is an APIError caused by a missing or invalid API token
+func IsUnauthorized(err error) bool {
+	return hasStatus(err, http.StatusUnauthorized)
+}
+
+// IsForbidden reports whether err is an APIError for a resource the user may not access
+func IsForbidden(err error) bool {
+	return hasStatus(err, http.StatusForbidden)
+}
+
+// IsRateLimited reports whether err is an APIError caused by exceeding the Todoist request quota
+func IsRateLimited(err error) bool {
+	return hasStatus(err, http.StatusTooManyRequests)
+}
+
+// IsBadRequest reports whether err is an APIError for a request Todoist rejected as invalid
+func IsBadRequest(err error) bool {
+	return hasStatus(err, http.StatusBadRequest)
+}
+
+// describeAPIError explains an API error in terms of the resource a tool acted on,
+// e.g. "task 123 does not exist or was deleted". The id may be empty, and so may the
+// resource when the request names none that could be missing or inaccessible.
+// It returns an empty string for errors that are not APIErrors.
+func describeAPIError(err error, resource, id string) string {
+	var apiErr *APIError
+	if !errors.As(err, &apiErr) {
+		return ""
+	}
+
+	subject := resource
+	if id != "" {
+		subject = resource + " " + id
+	}
+
+	switch {
+	case IsNotFound(err) && subject != "":
+		return subject + " does not exist or was deleted"
+	case IsUnauthorized(err):
+		return "the Todoist API token is missing, invalid or revoked; check TODOIST_API_TOKEN"
+	case IsForbidden(err) && subject != "":
+		return "access to " + subject + " is forbidden; it may belong to a project the user is not a member of"
+	case IsRateLimited(err):
+		if apiErr.RetryAfter > 0 {
+			return fmt.Sprintf("the Todoist rate limit was reached; try again in %s", apiErr.RetryAfter.Round(time.Second))
+		}
+		return "the Todoist rate limit was reached; try again later"
+	case IsBadRequest(err):
+		return "Todoist rejected the request as invalid; check the parameters"
+	case apiErr.StatusCode >= 500:
+		return "Todoist is temporarily unavailable; try again later"
+	}
+	return ""
+}
+
+// namedResource returns resource if the request names one by id, and an empty resource
+// otherwise, so that errors are not blamed on a resource the request did not name
+func namedResource(resource, id string) string {
+	if id == "" {
+		return ""
+	}
+	return resource
+}
+
+// newToolResultAPIError creates an error result whose message explains API errors
+// in terms of the resource the tool acted on
+func newToolResultAPIError(msg string, err error, resource, id string) *mcp.CallToolResult {
+	description := describeAPIError(err, resource, id)
+	if description == "" {
+		return newToolResultError(msg, err)
+	}
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("%s: %s (%s)", msg, description, err.Error())}},
+		IsError: true,
+	}
+}
@@ -0,0 +1,227 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIErrorFromResponse(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := MockResponse(429, map[string]interface{}{
+			"error":      "Too many requests",
+			"error_code": 35,
+			"error_tag":  "LIMITS_REACHED",
+			"http_code":  429,
+		})
+		resp.Header.Set("Retry-After", "30")
+		resp.Header.Set("X-Request-Id", "req-1")
+		return resp, nil
+	})
+
+	_, err := client.GetTask(context.Background(), "123456789")
+
+	var apiErr *APIError
+	assert.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, 429, apiErr.StatusCode)
+	assert.Equal(t, 35, apiErr.ErrorCode)
+	assert.Equal(t, "LIMITS_REACHED", apiErr.ErrorTag)
+	assert.Equal(t, "Too many requests", apiErr.Message)
+	assert.Equal(t, "req-1", apiErr.RequestID)
+	assert.Equal(t, 30*time.Second, apiErr.RetryAfter)
+	assert.Equal(t, "API request failed with status 429: Too many requests (tag LIMITS_REACHED, code 35, request id req-1)", err.Error())
+	assert.True(t, IsRateLimited(err))
+}
+
+func TestAPIErrorPlainBody(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		resp := MockResponse(403, nil)
+		resp.Body = http.NoBody
+		return resp, nil
+	})
+
+	err := client.DeleteTask(context.Background(), "123456789")
+	assert.True(t, IsForbidden(err))
+	assert.Equal(t, "API request failed with status 403", err.Error())
+}
+
+func TestAPIErrorHelpers(t *testing.T) {
+	tests := []struct {
+		status         int
+		isNotFound     bool
+		isUnauthorized bool
+		isForbidden    bool
+		isRateLimited  bool
+		isBadRequest   bool
+	}{
+		{status: 400, isBadRequest: true},
+		{status: 401, isUnauthorized: true},
+		{status: 403, isForbidden: true},
+		{status: 404, isNotFound: true},
+		{status: 429, isRateLimited: true},
+		{status: 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(http.StatusText(tt.status), func(t *testing.T) {
+			// Helpers see through wrapping
+			err := fmt.Errorf("wrapped: %w", &APIError{StatusCode: tt.status})
+			assert.Equal(t, tt.isNotFound, IsNotFound(err))
+			assert.Equal(t, tt.isUnauthorized, IsUnauthorized(err))
+			assert.Equal(t, tt.isForbidden, IsForbidden(err))
+			assert.Equal(t, tt.isRateLimited, IsRateLimited(err))
+			assert.Equal(t, tt.isBadRequest, IsBadRequest(err))
+		})
+	}
+
+	assert.False(t, IsNotFound(fmt.Errorf("network error")))
+}
+
+func TestHandlerAPIErrorMessages(t *testing.T) {
+	tests := []struct {
+		name     string
+		status   int
+		header   http.Header
+		call     func(tp *ToolProvider) (string, bool)
+		wantText string
+	}{
+		{
+			name:   "task not found",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleGetTask, MockCallToolRequest(map[string]interface{}{"id": "123"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to get task: task 123 does not exist or was deleted (API request failed with status 404",
+		},
+		{
+			name:   "invalid token",
+			status: 401,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleCloseTask, MockCallToolRequest(map[string]interface{}{"id": "123"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to close task: the Todoist API token is missing, invalid or revoked",
+		},
+		{
+			name:   "rate limited",
+			status: 429,
+			header: http.Header{"Retry-After": []string{"20"}},
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleGetProjects, MockCallToolRequest(map[string]interface{}{}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to get projects: the Todoist rate limit was reached; try again in 20s",
+		},
+		{
+			name:   "project not found",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleArchiveProject, MockCallToolRequest(map[string]interface{}{"id": "987"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to archive project: project 987 does not exist or was deleted",
+		},
+		{
+			name:   "task or section not found",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := tp.HandleMoveTaskToSection(context.Background(), MockCallToolRequest(map[string]interface{}{"id": "123", "sectionId": "7025"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to move task to section: task or section 123 does not exist or was deleted",
+		},
+		{
+			name:   "parent project not found",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleCreateProject, MockCallToolRequest(map[string]interface{}{"name": "Clients", "parentId": "987"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to create project: parent project 987 does not exist or was deleted",
+		},
+		{
+			name:   "project rejected without parent",
+			status: 400,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleCreateProject, MockCallToolRequest(map[string]interface{}{"name": "Clients"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to create project: Todoist rejected the request as invalid; check the parameters (API request failed with status 400",
+		},
+		{
+			name:   "tasks not found without project",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to get tasks: API request failed with status 404",
+		},
+		{
+			name:   "completed tasks not found without project",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleGetCompletedTasks, MockCallToolRequest(map[string]interface{}{}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to get completed tasks: API request failed with status 404",
+		},
+		{
+			name:   "quick add not found",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleQuickAddTask, MockCallToolRequest(map[string]interface{}{"text": "Call mom"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to quick add task: API request failed with status 404",
+		},
+		{
+			name:   "create task not found without location",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(map[string]interface{}{"content": "Task"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to create task: API request failed with status 404",
+		},
+		{
+			name:   "tasks of a missing project",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{"projectId": "987"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to get tasks: project 987 does not exist or was deleted",
+		},
+		{
+			name:   "not found without parent",
+			status: 404,
+			call: func(tp *ToolProvider) (string, bool) {
+				result, _ := CallTypedTool(context.Background(), tp.HandleCreateProject, MockCallToolRequest(map[string]interface{}{"name": "Clients"}))
+				return resultText(result), result.IsError
+			},
+			wantText: "Failed to create project: API request failed with status 404",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+				resp := MockResponse(tt.status, nil)
+				for key, values := range tt.header {
+					resp.Header[key] = values
+				}
+				return resp, nil
+			})
+
+			text, isError := tt.call(tp)
+			assert.True(t, isError)
+			assert.Contains(t, text, tt.wantText)
+		})
+	}
+}
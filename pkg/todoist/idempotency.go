@@ -0,0 +1,155 @@
+package todoist
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strconv"
+	"sync"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
+)
+
+// maxRequestIDLength is the longest X-Request-Id Todoist accepts
+const maxRequestIDLength = 36
+
+// requestIDKey is the context key for the request ID sequence of a logical operation
+type requestIDKey struct{}
+
+// requestIDSequence hands out idempotency keys for the requests of one logical operation.
+// The first request uses the ID itself; later ones use UUIDs derived from it, so that
+// repeating the whole operation with the same ID repeats the same keys.
+type requestIDSequence struct {
+	mu   sync.Mutex
+	id   string
+	sent int
+}
+
+// next returns the idempotency key for the next request
+func (s *requestIDSequence) next() string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.sent++
+	if s.sent == 1 {
+		return s.id
+	}
+	return derivedUUID(s.id + "/" + strconv.Itoa(s.sent))
+}
+
+// derivedUUID returns a name-based (version 5 style) UUID for name
+func derivedUUID(name string) string {
+	b := sha1.Sum([]byte(name))
+	b[6] = (b[6] & 0x0f) | 0x50
+	b[8] = (b[8] & 0x3f) | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:16])
+}
+
+// WithRequestID returns a context whose mutating requests are deduplicated by Todoist using id.
+// Sending the same operation again with the same id has no further effect.
+// The id must be at most 36 characters long, e.g. a UUID.
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, &requestIDSequence{id: id})
+}
+
+// withoutRequestID returns a context whose requests take no keys from the request ID
+// sequence of ctx. Reads sent as POST, such as syncs, use it, so that the keys of the
+// writes of an operation do not depend on whether it had to read first.
+func withoutRequestID(ctx context.Context) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, (*requestIDSequence)(nil))
+}
+
+// idempotencyKey returns the X-Request-Id for a mutating request: the next key of the
+// context's request ID sequence, or a fresh UUID
+func idempotencyKey(ctx context.Context) string {
+	if seq, ok := ctx.Value(requestIDKey{}).(*requestIDSequence); ok && seq != nil {
+		return seq.next()
+	}
+	return newUUID()
+}
+
+// requestIDFrom returns the request ID of the operation ctx belongs to, if it has one
+func requestIDFrom(ctx context.Context) (string, bool) {
+	if seq, ok := ctx.Value(requestIDKey{}).(*requestIDSequence); ok && seq != nil {
+		return seq.id, true
+	}
+	return "", false
+}
+
+// isMutatingMethod reports whether requests with the method change data
+func isMutatingMethod(method string) bool {
+	return method == http.MethodPost || method == http.MethodDelete
+}
+
+// withRequestID adds the optional requestId argument to a write tool.
+// Each call runs with a request ID, taken from the argument or generated, that is logged
+// and sent as the idempotency key of the Todoist requests made by the call.
+func (tp *ToolProvider) withRequestID(tool toolsets.ServerTool) toolsets.ServerTool {
+	tool.Tool.InputSchema = addRequestIDProperty(tool.Tool.InputSchema)
+
+	name := tool.Tool.Name
+	return tool.Wrap(func(handler toolsets.ToolHandlerFunc) toolsets.ToolHandlerFunc {
+		return func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			requestID, err := OptionalParam[string](request, "requestId")
+			if err != nil {
+				return newToolResultError("Invalid parameter: requestId", err), nil
+			}
+			if len(requestID) > maxRequestIDLength {
+				return newToolResultError("Invalid parameter: requestId", fmt.Errorf("requestId may be at most %d characters long", maxRequestIDLength)), nil
+			}
+			if requestID == "" {
+				requestID = newUUID()
+			}
+
+			tp.logger.WithFields(map[string]interface{}{
+				"tool":      name,
+				"requestId": requestID,
+			}).Info("Handling write tool call")
+
+			return handler(WithRequestID(ctx, requestID), request)
+		}
+	})
+}
+
+// writeTools applies withRequestID to each tool
+func (tp *ToolProvider) writeTools(tools ...toolsets.ServerTool) []toolsets.ServerTool {
+	wrapped := make([]toolsets.ServerTool, len(tools))
+	for i, tool := range tools {
+		wrapped[i] = tp.withRequestID(tool)
+	}
+	return wrapped
+}
+
+// addRequestIDProperty returns a copy of an object input schema with a requestId property.
+// Schemas that cannot be decoded are returned unchanged.
+func addRequestIDProperty(inputSchema any) any {
+	schemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		return inputSchema
+	}
+
+	var schema map[string]interface{}
+	if err := json.Unmarshal(schemaJSON, &schema); err != nil {
+		return inputSchema
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = map[string]interface{}{}
+	}
+	properties["requestId"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Optional idempotency key of up to 36 characters, e.g. a UUID. Repeating a call with the same requestId does not apply the change twice.",
+		"maxLength":   maxRequestIDLength,
+	}
+	schema["properties"] = properties
+
+	schemaJSON, err = json.Marshal(schema)
+	if err != nil {
+		return inputSchema
+	}
+	return json.RawMessage(schemaJSON)
+}
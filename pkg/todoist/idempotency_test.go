@@ -0,0 +1,129 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
+	"github.com/stretchr/testify/assert"
+)
+
+var uuidPattern = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+
+func TestIdempotencyKeyHeader(t *testing.T) {
+	var keys []string
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		keys = append(keys, req.Header.Get(idempotencyKeyHeader))
+		if len(keys) == 1 && req.Method == "POST" {
+			return MockResponse(503, nil), nil
+		}
+		return MockResponse(200, MockTask()), nil
+	})
+	WithRetryPolicy(fastRetryPolicy)(client)
+
+	// Reads carry no key
+	_, err := client.GetTask(context.Background(), "123456789")
+	assert.NoError(t, err)
+	assert.Equal(t, []string{""}, keys)
+
+	// Writes carry a generated key that is reused when retried
+	keys = nil
+	_, err = client.CreateTask(context.Background(), CreateTaskRequest{Content: "Task"})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 2)
+	assert.Regexp(t, uuidPattern, keys[0])
+	assert.Equal(t, keys[0], keys[1])
+
+	// Separate operations get separate keys
+	_, err = client.CreateTask(context.Background(), CreateTaskRequest{Content: "Task"})
+	assert.NoError(t, err)
+	assert.Len(t, keys, 3)
+	assert.NotEqual(t, keys[0], keys[2])
+}
+
+func TestWithRequestID(t *testing.T) {
+	run := func() []string {
+		var keys []string
+		client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+			keys = append(keys, req.Header.Get(idempotencyKeyHeader))
+			return MockResponse(200, MockTask()), nil
+		})
+
+		ctx := WithRequestID(context.Background(), "op-1")
+		_, err := client.UpdateTask(ctx, "123456789", UpdateTaskRequest{Content: "Task"})
+		assert.NoError(t, err)
+		_, err = client.MoveTask(ctx, "123456789", MoveTaskRequest{ProjectID: "1"})
+		assert.NoError(t, err)
+		return keys
+	}
+
+	keys := run()
+	assert.Len(t, keys, 2)
+	assert.Equal(t, "op-1", keys[0])
+	assert.Regexp(t, uuidPattern, keys[1])
+
+	// Repeating the operation repeats the keys, so Todoist can deduplicate it
+	assert.Equal(t, keys, run())
+}
+
+func TestWriteToolRequestID(t *testing.T) {
+	var keys []string
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		keys = append(keys, req.Header.Get(idempotencyKeyHeader))
+		return MockResponse(200, MockTask()), nil
+	})
+	tool := tp.withRequestID(toolsets.NewTypedServerTool(tp.CreateTask(), tp.HandleCreateTask))
+
+	// The schema advertises the argument
+	schemaBytes, err := json.Marshal(tool.Tool.InputSchema)
+	assert.NoError(t, err)
+	var schema map[string]interface{}
+	assert.NoError(t, json.Unmarshal(schemaBytes, &schema))
+	properties := schema["properties"].(map[string]interface{})
+	assert.Contains(t, properties, "requestId")
+	assert.Contains(t, properties, "content")
+
+	// The argument becomes the idempotency key
+	result, err := tool.Handler(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"content":   "Task",
+		"requestId": "2d5f3b9e-6a1c-4f0e-9b7a-3c8d1e2f4a5b",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, []string{"2d5f3b9e-6a1c-4f0e-9b7a-3c8d1e2f4a5b"}, keys)
+
+	// Without the argument a key is generated
+	keys = nil
+	result, err = tool.Handler(context.Background(), MockCallToolRequest(map[string]interface{}{"content": "Task"}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Regexp(t, uuidPattern, keys[0])
+
+	// Overlong keys are rejected before calling Todoist
+	keys = nil
+	result, err = tool.Handler(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"content":   "Task",
+		"requestId": strings.Repeat("x", 37),
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Empty(t, keys)
+}
+
+func TestWriteToolsAcceptRequestID(t *testing.T) {
+	tp := NewMockToolProvider()
+	group := createDefaultToolsetGroup(tp, false)
+
+	for _, toolset := range group.Toolsets {
+		for _, tool := range toolset.GetActiveTools() {
+			schemaBytes, err := json.Marshal(tool.Tool.InputSchema)
+			assert.NoError(t, err)
+			isWrite := tool.Tool.Annotations == nil || !tool.Tool.Annotations.ReadOnlyHint
+			assert.Equal(t, isWrite, strings.Contains(string(schemaBytes), `"requestId"`), tool.Tool.Name)
+		}
+	}
+}
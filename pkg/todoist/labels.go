@@ -0,0 +1,492 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetLabelsResponse represents the response from the todoist_get_labels tool
+type GetLabelsResponse struct {
+	Labels []Label `json:"labels"`
+}
+
+// GetLabelResponse represents the response from the todoist_get_label tool
+type GetLabelResponse struct {
+	Label Label `json:"label"`
+}
+
+// CreateLabelResponse represents the response from the todoist_create_label tool
+type CreateLabelResponse struct {
+	Label Label `json:"label"`
+}
+
+// UpdateLabelResponse represents the response from the todoist_update_label tool
+type UpdateLabelResponse struct {
+	Label Label `json:"label"`
+}
+
+// GetLabels returns the todoist_get_labels tool
+func (tp *ToolProvider) GetLabels() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{},
+	}
+
+	addFormatProperties(inputSchema, labelRenderer)
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetLabelsResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_get_labels",
+		Description:  "Get a list of personal labels.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetLabels handles the todoist_get_labels tool request
+func (tp *ToolProvider) HandleGetLabels(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, labelRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	// Log the request
+	tp.logger.Info("Getting labels")
+
+	// Call the Todoist API
+	labels, err := tp.client.GetLabels(ctx)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get labels")
+		return newToolResultError("Failed to get labels", err), nil
+	}
+
+	// Convert labels to JSON
+	response := GetLabelsResponse{
+		Labels: labels,
+	}
+
+	// Return the response in the requested format
+	return renderList(format, labelRenderer, response, "labels", labels, nil), nil
+}
+
+// GetLabel returns the todoist_get_label tool
+func (tp *ToolProvider) GetLabel() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the label to retrieve. Specify the numeric Todoist label ID (e.g., '2156154810').",
+			},
+		},
+	}
+
+	addFormatProperties(inputSchema, labelRenderer)
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetLabelResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_get_label",
+		Description:  "Get a personal label by ID.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetLabel handles the todoist_get_label tool request
+func (tp *ToolProvider) HandleGetLabel(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, labelRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Getting label")
+
+	// Call the Todoist API
+	label, err := tp.client.GetLabel(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get label")
+		return newToolResultError("Failed to get label", err), nil
+	}
+
+	// Convert label to JSON
+	response := GetLabelResponse{
+		Label: *label,
+	}
+
+	// Return the response in the requested format
+	return renderObject(format, labelRenderer, response, "label", *label), nil
+}
+
+// CreateLabel returns the todoist_create_label tool
+func (tp *ToolProvider) CreateLabel() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"name"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "The name of the label (required). Must be unique among the user's personal labels.",
+			},
+			"color": map[string]interface{}{
+				"type":        "string",
+				"description": "The color of the label, as a Todoist color name (e.g., 'berry_red', 'blue', 'grey').",
+			},
+			"order": map[string]interface{}{
+				"type":        "integer",
+				"description": "Order value for positioning the label in the label list. Labels are sorted by this value in ascending order.",
+			},
+			"isFavorite": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the label is marked as a favorite.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[CreateLabelResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_create_label",
+		Description:  "Create a new personal label.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleCreateLabel handles the todoist_create_label tool request
+func (tp *ToolProvider) HandleCreateLabel(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	name, err := RequiredParam[string](request, "name")
+	if err != nil {
+		return newToolResultError("Missing required parameter: name", err), nil
+	}
+
+	color, err := OptionalParam[string](request, "color")
+	if err != nil {
+		return newToolResultError("Invalid parameter: color", err), nil
+	}
+	order, err := OptionalPtrParam[int](request, "order")
+	if err != nil {
+		return newToolResultError("Invalid parameter: order", err), nil
+	}
+	isFavorite, err := OptionalParam[bool](request, "isFavorite")
+	if err != nil {
+		return newToolResultError("Invalid parameter: isFavorite", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"name":  name,
+		"color": color,
+	}).Info("Creating label")
+
+	// Create request
+	createReq := CreateLabelRequest{
+		Name:       name,
+		Color:      color,
+		Order:      order,
+		IsFavorite: isFavorite,
+	}
+
+	// Call the Todoist API
+	label, err := tp.client.CreateLabel(ctx, createReq)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to create label")
+		return newToolResultError("Failed to create label", err), nil
+	}
+
+	// Convert label to JSON
+	response := CreateLabelResponse{
+		Label: *label,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// UpdateLabel returns the todoist_update_label tool
+func (tp *ToolProvider) UpdateLabel() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the label to update (required). Specify the numeric Todoist label ID (e.g., '2156154810').",
+			},
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "The new name of the label. Renaming a label also updates it on every task that uses it.",
+			},
+			"color": map[string]interface{}{
+				"type":        "string",
+				"description": "The new color of the label, as a Todoist color name (e.g., 'berry_red', 'blue', 'grey').",
+			},
+			"order": map[string]interface{}{
+				"type":        "integer",
+				"description": "Order value for positioning the label in the label list. Labels are sorted by this value in ascending order.",
+			},
+			"isFavorite": map[string]interface{}{
+				"type":        "boolean",
+				"description": "Whether the label is marked as a favorite.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[UpdateLabelResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_update_label",
+		Description:  "Update an existing personal label.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleUpdateLabel handles the todoist_update_label tool request
+func (tp *ToolProvider) HandleUpdateLabel(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	name, err := OptionalParam[string](request, "name")
+	if err != nil {
+		return newToolResultError("Invalid parameter: name", err), nil
+	}
+	color, err := OptionalParam[string](request, "color")
+	if err != nil {
+		return newToolResultError("Invalid parameter: color", err), nil
+	}
+	order, err := OptionalPtrParam[int](request, "order")
+	if err != nil {
+		return newToolResultError("Invalid parameter: order", err), nil
+	}
+	isFavorite, err := OptionalPtrParam[bool](request, "isFavorite")
+	if err != nil {
+		return newToolResultError("Invalid parameter: isFavorite", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"id":    id,
+		"name":  name,
+		"color": color,
+	}).Info("Updating label")
+
+	// Create request
+	updateReq := UpdateLabelRequest{
+		Name:       name,
+		Color:      color,
+		Order:      order,
+		IsFavorite: isFavorite,
+	}
+
+	// Call the Todoist API
+	label, err := tp.client.UpdateLabel(ctx, id, updateReq)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to update label")
+		return newToolResultError("Failed to update label", err), nil
+	}
+
+	// Convert label to JSON
+	response := UpdateLabelResponse{
+		Label: *label,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// DeleteLabel returns the todoist_delete_label tool
+func (tp *ToolProvider) DeleteLabel() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the label to delete (required). Specify the numeric Todoist label ID (e.g., '2156154810'). The label is removed from all tasks. Warning: This action is permanent and cannot be undone.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_delete_label",
+		Description:  "Delete a personal label.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleDeleteLabel handles the todoist_delete_label tool request
+func (tp *ToolProvider) HandleDeleteLabel(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Deleting label")
+
+	// Call the Todoist API
+	err = tp.client.DeleteLabel(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to delete label")
+		return newToolResultError("Failed to delete label", err), nil
+	}
+
+	// Return success response
+	return jsonResult(SuccessResponse{Success: true}), nil
+}
+
+// RenameSharedLabel returns the todoist_rename_shared_label tool
+func (tp *ToolProvider) RenameSharedLabel() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"name", "newName"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "The current name of the label (required). Shared labels are identified by name rather than ID.",
+			},
+			"newName": map[string]interface{}{
+				"type":        "string",
+				"description": "The new name of the label (required).",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_rename_shared_label",
+		Description:  "Rename a label on every task that uses it, including labels that only exist on shared tasks.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleRenameSharedLabel handles the todoist_rename_shared_label tool request
+func (tp *ToolProvider) HandleRenameSharedLabel(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	name, err := RequiredParam[string](request, "name")
+	if err != nil {
+		return newToolResultError("Missing required parameter: name", err), nil
+	}
+
+	newName, err := RequiredParam[string](request, "newName")
+	if err != nil {
+		return newToolResultError("Missing required parameter: newName", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"name":    name,
+		"newName": newName,
+	}).Info("Renaming shared label")
+
+	// Call the Todoist API
+	err = tp.client.RenameSharedLabel(ctx, name, newName)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to rename shared label")
+		return newToolResultError("Failed to rename shared label", err), nil
+	}
+
+	// Return success response
+	return jsonResult(SuccessResponse{Success: true}), nil
+}
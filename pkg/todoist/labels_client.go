@@ -0,0 +1,136 @@
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+)
+
+// GetLabels retrieves all personal labels, following next_cursor across pages
+func (c *Client) GetLabels(ctx context.Context) ([]Label, error) {
+	return collect(c.AllLabels(ctx))
+}
+
+// AllLabels returns an iterator over all personal labels that fetches pages lazily
+func (c *Client) AllLabels(ctx context.Context) iter.Seq2[Label, error] {
+	return paginate[Label](ctx, c, "/labels", nil, "get labels")
+}
+
+// GetLabel retrieves a specific personal label by ID
+func (c *Client) GetLabel(ctx context.Context, id string) (*Label, error) {
+	endpoint := fmt.Sprintf("/labels/%s", id)
+
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get label: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var label Label
+	if err := json.Unmarshal(bodyBytes, &label); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &label, nil
+}
+
+// CreateLabel creates a new personal label
+func (c *Client) CreateLabel(ctx context.Context, req CreateLabelRequest) (*Label, error) {
+	endpoint := "/labels"
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create label: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var label Label
+	if err := json.Unmarshal(bodyBytes, &label); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &label, nil
+}
+
+// UpdateLabel updates an existing personal label
+func (c *Client) UpdateLabel(ctx context.Context, id string, req UpdateLabelRequest) (*Label, error) {
+	endpoint := fmt.Sprintf("/labels/%s", id)
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update label: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var label Label
+	if err := json.Unmarshal(bodyBytes, &label); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &label, nil
+}
+
+// DeleteLabel deletes a personal label. The label is also removed from all tasks.
+func (c *Client) DeleteLabel(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/labels/%s", id)
+
+	resp, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete label: %w", err)
+	}
+
+	_, err = c.processResponse(resp, http.StatusNoContent)
+	return err
+}
+
+// RenameSharedLabel renames every occurrence of a shared label across the user's tasks
+func (c *Client) RenameSharedLabel(ctx context.Context, name, newName string) error {
+	endpoint := "/labels/shared/rename"
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(RenameSharedLabelRequest{
+		Name:    name,
+		NewName: newName,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return fmt.Errorf("failed to rename shared label: %w", err)
+	}
+
+	_, err = c.processResponse(resp, http.StatusNoContent)
+	return err
+}
@@ -0,0 +1,248 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetLabelsTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.GetLabels()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_get_labels", tool.Name)
+	assert.Equal(t, "Get a list of personal labels.", tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+}
+
+func TestHandleGetLabels(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, PaginatedResponse[Label]{Results: []Label{*MockLabel()}}), nil
+	})
+
+	result, err := tp.HandleGetLabels(context.Background(), MockCallToolRequest(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var response GetLabelsResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Len(t, response.Labels, 1)
+	assert.Equal(t, "waiting", response.Labels[0].Name)
+}
+
+func TestGetLabelTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.GetLabel()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_get_label", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	// Check input schema
+	var schema map[string]interface{}
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+	err = json.Unmarshal(schemaBytes, &schema)
+	assert.NoError(t, err)
+
+	// Check schema type and required fields
+	assert.Equal(t, "object", schema["type"])
+	required, ok := schema["required"].([]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, required, "id")
+}
+
+func TestHandleGetLabel(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, MockLabel()), nil
+	})
+
+	// Missing id
+	result, err := tp.HandleGetLabel(context.Background(), MockCallToolRequest(map[string]interface{}{}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	// Success
+	result, err = tp.HandleGetLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id": "2156154810",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var response GetLabelResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Equal(t, "2156154810", response.Label.ID)
+}
+
+func TestCreateLabelTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.CreateLabel()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_create_label", tool.Name)
+	assert.Nil(t, tool.Annotations)
+
+	// Check input schema
+	var schema map[string]interface{}
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+	err = json.Unmarshal(schemaBytes, &schema)
+	assert.NoError(t, err)
+
+	required, ok := schema["required"].([]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, required, "name")
+
+	properties, ok := schema["properties"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, properties, "color")
+	assert.Contains(t, properties, "order")
+	assert.Contains(t, properties, "isFavorite")
+}
+
+func TestHandleCreateLabel(t *testing.T) {
+	var body CreateLabelRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockLabel()), nil
+	})
+
+	result, err := tp.HandleCreateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name":       "waiting",
+		"color":      "charcoal",
+		"order":      3,
+		"isFavorite": true,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	assert.Equal(t, CreateLabelRequest{
+		Name:       "waiting",
+		Color:      "charcoal",
+		Order:      intPtr(3),
+		IsFavorite: true,
+	}, body)
+
+	// A numeric string order is accepted
+	body = CreateLabelRequest{}
+	result, err = tp.HandleCreateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name":  "waiting",
+		"order": "5",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, intPtr(5), body.Order)
+
+	// A fractional order is reported instead of being truncated
+	result, err = tp.HandleCreateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name":  "waiting",
+		"order": 2.5,
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "Invalid parameter: order")
+}
+
+func TestHandleUpdateLabel(t *testing.T) {
+	var body map[string]interface{}
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/labels/2156154810", req.URL.Path)
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockLabel()), nil
+	})
+
+	result, err := tp.HandleUpdateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":         "2156154810",
+		"isFavorite": false,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, map[string]interface{}{"is_favorite": false}, body)
+
+	// A JSON number order is sent as the new position
+	body = nil
+	result, err = tp.HandleUpdateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":    "2156154810",
+		"order": 7,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, map[string]interface{}{"order": float64(7)}, body)
+
+	// Order 0 moves the label to the top rather than being dropped
+	body = nil
+	result, err = tp.HandleUpdateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":    "2156154810",
+		"order": 0,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, map[string]interface{}{"order": float64(0)}, body)
+
+	// A non-numeric order is reported instead of being dropped
+	body = nil
+	result, err = tp.HandleUpdateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":    "2156154810",
+		"order": "last",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "Invalid parameter: order")
+	assert.Nil(t, body)
+
+	// Wrongly typed favorite flag is reported instead of ignored
+	result, err = tp.HandleUpdateLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":         "2156154810",
+		"isFavorite": "yes",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+}
+
+func TestHandleDeleteLabel(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		return MockResponse(204, nil), nil
+	})
+
+	result, err := tp.HandleDeleteLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id": "2156154810",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.JSONEq(t, `{"success": true}`, resultText(result))
+}
+
+func TestHandleRenameSharedLabel(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/labels/shared/rename", req.URL.Path)
+		return MockResponse(204, nil), nil
+	})
+
+	// newName is required
+	result, err := tp.HandleRenameSharedLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name": "old",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	result, err = tp.HandleRenameSharedLabel(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name":    "old",
+		"newName": "new",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+}
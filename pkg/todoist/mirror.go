@@ -0,0 +1,333 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	todoistsync "github.com/naotama2002/todoist-go-mcp-server/pkg/todoist/sync"
+	"github.com/sirupsen/logrus"
+)
+
+// Sync requests the given resource types from the Sync API and returns the raw response body.
+// A syncToken of "*" requests all data; any other token requests the changes since that sync.
+func (c *Client) Sync(ctx context.Context, syncToken string, resourceTypes []string) ([]byte, error) {
+	endpoint := "/sync"
+
+	resourceTypesJSON, err := json.Marshal(resourceTypes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal resource types: %w", err)
+	}
+	form := url.Values{}
+	form.Set("sync_token", syncToken)
+	form.Set("resource_types", string(resourceTypesJSON))
+
+	// A sync only reads, so it must not use up the idempotency keys of the calling write
+	resp, err := c.doRequestWithContentType(withoutRequestID(ctx), "POST", endpoint, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	if err != nil {
+		return nil, fmt.Errorf("failed to sync: %w", err)
+	}
+
+	return c.processResponse(resp, http.StatusOK)
+}
+
+// mirroredClient serves reads from a Sync API mirror that is at most maxStaleness old.
+// Writes go to the wrapped client and mark the mirror stale, so that the next read
+// syncs the change. Reads the mirror cannot answer fall back to the wrapped client.
+type mirroredClient struct {
+	TodoistClient
+	mirror       *todoistsync.Mirror
+	maxStaleness time.Duration
+	logger       *logrus.Logger
+}
+
+// UseSyncMirror makes the read tools serve data from the mirror, syncing it when it is
+// older than maxStaleness or after a write
+func (tp *ToolProvider) UseSyncMirror(mirror *todoistsync.Mirror, maxStaleness time.Duration) {
+	tp.client = &mirroredClient{
+		TodoistClient: tp.client,
+		mirror:        mirror,
+		maxStaleness:  maxStaleness,
+		logger:        tp.logger,
+	}
+}
+
+// refresh brings the mirror within the staleness bound, reporting whether it can be used
+func (c *mirroredClient) refresh(ctx context.Context) bool {
+	if err := c.mirror.Refresh(ctx, c.maxStaleness); err != nil {
+		c.logger.WithError(err).Warn("Failed to sync Todoist mirror, falling back to the REST API")
+		return false
+	}
+	return true
+}
+
+// decodeMirrored decodes mirrored objects, keeping those for which keep returns true
+func decodeMirrored[T any](objects []json.RawMessage, keep func(*T) bool) ([]T, error) {
+	items := []T{}
+	for _, object := range objects {
+		var item T
+		if err := json.Unmarshal(object, &item); err != nil {
+			return nil, fmt.Errorf("failed to decode mirrored object: %w", err)
+		}
+		if keep(&item) {
+			items = append(items, item)
+		}
+	}
+	return items, nil
+}
+
+// getMirrored decodes the mirrored object with the given ID, reporting whether it was found
+func getMirrored[T any](mirror *todoistsync.Mirror, resource todoistsync.Resource, id string) (*T, bool) {
+	object, ok := mirror.Get(resource, id)
+	if !ok {
+		return nil, false
+	}
+	var item T
+	if err := json.Unmarshal(object, &item); err != nil {
+		return nil, false
+	}
+	return &item, true
+}
+
+// GetTasks returns active tasks from the mirror. Filter queries are evaluated by Todoist.
+func (c *mirroredClient) GetTasks(ctx context.Context, projectID, filter string) ([]Task, error) {
+	if filter != "" || !c.refresh(ctx) {
+		return c.TodoistClient.GetTasks(ctx, projectID, filter)
+	}
+	return decodeMirrored(c.mirror.All(todoistsync.Items), func(task *Task) bool {
+		return !task.Checked && !task.IsDeleted && (projectID == "" || task.ProjectID == projectID)
+	})
+}
+
+// GetTask returns a task from the mirror
+func (c *mirroredClient) GetTask(ctx context.Context, id string) (*Task, error) {
+	if c.refresh(ctx) {
+		if task, ok := getMirrored[Task](c.mirror, todoistsync.Items, id); ok {
+			return task, nil
+		}
+	}
+	return c.TodoistClient.GetTask(ctx, id)
+}
+
+// GetProjects returns the active projects from the mirror
+func (c *mirroredClient) GetProjects(ctx context.Context) ([]Project, error) {
+	if !c.refresh(ctx) {
+		return c.TodoistClient.GetProjects(ctx)
+	}
+	return decodeMirrored(c.mirror.All(todoistsync.Projects), func(project *Project) bool {
+		return !project.IsArchived && !project.IsDeleted
+	})
+}
+
+// GetProject returns a project from the mirror
+func (c *mirroredClient) GetProject(ctx context.Context, id string) (*Project, error) {
+	if c.refresh(ctx) {
+		if project, ok := getMirrored[Project](c.mirror, todoistsync.Projects, id); ok {
+			return project, nil
+		}
+	}
+	return c.TodoistClient.GetProject(ctx, id)
+}
+
+// GetSections returns the active sections from the mirror, optionally of one project
+func (c *mirroredClient) GetSections(ctx context.Context, projectID string) ([]Section, error) {
+	if !c.refresh(ctx) {
+		return c.TodoistClient.GetSections(ctx, projectID)
+	}
+	return decodeMirrored(c.mirror.All(todoistsync.Sections), func(section *Section) bool {
+		return !section.IsArchived && !section.IsDeleted && (projectID == "" || section.ProjectID == projectID)
+	})
+}
+
+// GetSection returns a section from the mirror
+func (c *mirroredClient) GetSection(ctx context.Context, id string) (*Section, error) {
+	if c.refresh(ctx) {
+		if section, ok := getMirrored[Section](c.mirror, todoistsync.Sections, id); ok {
+			return section, nil
+		}
+	}
+	return c.TodoistClient.GetSection(ctx, id)
+}
+
+// GetLabels returns the personal labels from the mirror
+func (c *mirroredClient) GetLabels(ctx context.Context) ([]Label, error) {
+	if !c.refresh(ctx) {
+		return c.TodoistClient.GetLabels(ctx)
+	}
+	return decodeMirrored(c.mirror.All(todoistsync.Labels), func(label *Label) bool {
+		return true
+	})
+}
+
+// GetLabel returns a personal label from the mirror
+func (c *mirroredClient) GetLabel(ctx context.Context, id string) (*Label, error) {
+	if c.refresh(ctx) {
+		if label, ok := getMirrored[Label](c.mirror, todoistsync.Labels, id); ok {
+			return label, nil
+		}
+	}
+	return c.TodoistClient.GetLabel(ctx, id)
+}
+
+// GetComments returns the comments of a task or project from the mirror
+func (c *mirroredClient) GetComments(ctx context.Context, taskID, projectID string) ([]Comment, error) {
+	if !c.refresh(ctx) {
+		return c.TodoistClient.GetComments(ctx, taskID, projectID)
+	}
+	if taskID != "" {
+		return decodeMirrored(c.mirror.All(todoistsync.Notes), func(comment *Comment) bool {
+			return !comment.IsDeleted && comment.ItemID != nil && *comment.ItemID == taskID
+		})
+	}
+	return decodeMirrored(c.mirror.All(todoistsync.ProjectNotes), func(comment *Comment) bool {
+		return !comment.IsDeleted && comment.ProjectID != nil && *comment.ProjectID == projectID
+	})
+}
+
+// CreateProject creates a project and marks the mirror stale
+func (c *mirroredClient) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.CreateProject(ctx, req)
+}
+
+// UpdateProject updates a project and marks the mirror stale
+func (c *mirroredClient) UpdateProject(ctx context.Context, id string, req UpdateProjectRequest) (*Project, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.UpdateProject(ctx, id, req)
+}
+
+// ArchiveProject archives a project and marks the mirror stale
+func (c *mirroredClient) ArchiveProject(ctx context.Context, id string) (*Project, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.ArchiveProject(ctx, id)
+}
+
+// UnarchiveProject unarchives a project and marks the mirror stale
+func (c *mirroredClient) UnarchiveProject(ctx context.Context, id string) (*Project, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.UnarchiveProject(ctx, id)
+}
+
+// DeleteProject deletes a project and marks the mirror stale
+func (c *mirroredClient) DeleteProject(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.DeleteProject(ctx, id)
+}
+
+// CreateTask creates a task and marks the mirror stale
+func (c *mirroredClient) CreateTask(ctx context.Context, req CreateTaskRequest) (*Task, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.CreateTask(ctx, req)
+}
+
+// QuickAddTask creates a task with Quick Add and marks the mirror stale
+func (c *mirroredClient) QuickAddTask(ctx context.Context, req QuickAddTaskRequest) (*Task, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.QuickAddTask(ctx, req)
+}
+
+// UpdateTask updates a task and marks the mirror stale
+func (c *mirroredClient) UpdateTask(ctx context.Context, id string, req UpdateTaskRequest) (*Task, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.UpdateTask(ctx, id, req)
+}
+
+// MoveTask moves a task and marks the mirror stale
+func (c *mirroredClient) MoveTask(ctx context.Context, id string, req MoveTaskRequest) (*Task, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.MoveTask(ctx, id, req)
+}
+
+// CloseTask closes a task and marks the mirror stale
+func (c *mirroredClient) CloseTask(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.CloseTask(ctx, id)
+}
+
+// ReopenTask reopens a task and marks the mirror stale
+func (c *mirroredClient) ReopenTask(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.ReopenTask(ctx, id)
+}
+
+// DeleteTask deletes a task and marks the mirror stale
+func (c *mirroredClient) DeleteTask(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.DeleteTask(ctx, id)
+}
+
+// CreateLabel creates a label and marks the mirror stale
+func (c *mirroredClient) CreateLabel(ctx context.Context, req CreateLabelRequest) (*Label, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.CreateLabel(ctx, req)
+}
+
+// UpdateLabel updates a label and marks the mirror stale
+func (c *mirroredClient) UpdateLabel(ctx context.Context, id string, req UpdateLabelRequest) (*Label, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.UpdateLabel(ctx, id, req)
+}
+
+// DeleteLabel deletes a label and marks the mirror stale
+func (c *mirroredClient) DeleteLabel(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.DeleteLabel(ctx, id)
+}
+
+// RenameSharedLabel renames a shared label and marks the mirror stale
+func (c *mirroredClient) RenameSharedLabel(ctx context.Context, name, newName string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.RenameSharedLabel(ctx, name, newName)
+}
+
+// CreateSection creates a section and marks the mirror stale
+func (c *mirroredClient) CreateSection(ctx context.Context, req CreateSectionRequest) (*Section, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.CreateSection(ctx, req)
+}
+
+// UpdateSection updates a section and marks the mirror stale
+func (c *mirroredClient) UpdateSection(ctx context.Context, id string, req UpdateSectionRequest) (*Section, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.UpdateSection(ctx, id, req)
+}
+
+// ReorderSections reorders sections and marks the mirror stale
+func (c *mirroredClient) ReorderSections(ctx context.Context, orders []SectionOrder) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.ReorderSections(ctx, orders)
+}
+
+// DeleteSection deletes a section and marks the mirror stale
+func (c *mirroredClient) DeleteSection(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.DeleteSection(ctx, id)
+}
+
+// CreateComment creates a comment and marks the mirror stale
+func (c *mirroredClient) CreateComment(ctx context.Context, req CreateCommentRequest) (*Comment, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.CreateComment(ctx, req)
+}
+
+// UpdateComment updates a comment and marks the mirror stale
+func (c *mirroredClient) UpdateComment(ctx context.Context, id string, req UpdateCommentRequest) (*Comment, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.UpdateComment(ctx, id, req)
+}
+
+// DeleteComment deletes a comment and marks the mirror stale
+func (c *mirroredClient) DeleteComment(ctx context.Context, id string) error {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.DeleteComment(ctx, id)
+}
+
+// ExecuteBatch executes Sync commands and marks the mirror stale
+func (c *mirroredClient) ExecuteBatch(ctx context.Context, commands []BatchCommand) (*BatchResult, error) {
+	defer c.mirror.MarkStale()
+	return c.TodoistClient.ExecuteBatch(ctx, commands)
+}
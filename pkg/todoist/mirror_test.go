@@ -0,0 +1,208 @@
+package todoist
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	todoistsync "github.com/naotama2002/todoist-go-mcp-server/pkg/todoist/sync"
+	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockSyncResponse is a full sync with two projects, three tasks and a comment
+const mockSyncResponse = `{
+	"sync_token": "token-1",
+	"full_sync": true,
+	"projects": [
+		{"id": "p1", "name": "Inbox", "is_archived": false},
+		{"id": "p2", "name": "Old", "is_archived": true}
+	],
+	"sections": [{"id": "s1", "project_id": "p1", "name": "Next"}],
+	"labels": [{"id": "l1", "name": "waiting"}],
+	"items": [
+		{"id": "t1", "project_id": "p1", "content": "Open task", "checked": false},
+		{"id": "t2", "project_id": "p1", "content": "Done task", "checked": true},
+		{"id": "t3", "project_id": "p2", "content": "Other task", "checked": false}
+	],
+	"notes": [{"id": "n1", "item_id": "t1", "content": "A note"}],
+	"project_notes": []
+}`
+
+// newMirroredMockClient returns a mirrored client whose REST and Sync requests are answered
+// by the mock, and counts the sync requests
+func newMirroredMockClient(t *testing.T, syncs *int, rest func(req *http.Request) (*http.Response, error)) *mirroredClient {
+	t.Helper()
+	return newMirroredMockToolProvider(t, syncs, rest).client.(*mirroredClient)
+}
+
+// newMirroredMockToolProvider returns a tool provider that reads through a mirrored client
+// as created by newMirroredMockClient
+func newMirroredMockToolProvider(t *testing.T, syncs *int, rest func(req *http.Request) (*http.Response, error)) *ToolProvider {
+	t.Helper()
+
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		if strings.HasSuffix(req.URL.Path, "/sync") {
+			*syncs++
+			body, _ := io.ReadAll(req.Body)
+			form, err := url.ParseQuery(string(body))
+			require.NoError(t, err)
+			if form.Get("sync_token") == "*" {
+				assert.Contains(t, form.Get("resource_types"), `"items"`)
+				return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(mockSyncResponse)), Header: make(http.Header)}, nil
+			}
+			return &http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(`{"sync_token":"token-2","full_sync":false}`)), Header: make(http.Header)}, nil
+		}
+		return rest(req)
+	})
+	client := tp.client.(*Client)
+
+	mirror, err := todoistsync.New(client, todoistsync.WithLogger(tp.logger))
+	require.NoError(t, err)
+	tp.UseSyncMirror(mirror, time.Hour)
+	return tp
+}
+
+func TestMirroredClientReads(t *testing.T) {
+	syncs := 0
+	client := newMirroredMockClient(t, &syncs, func(req *http.Request) (*http.Response, error) {
+		t.Fatalf("unexpected REST request: %s %s", req.Method, req.URL)
+		return nil, nil
+	})
+	ctx := context.Background()
+
+	tasks, err := client.GetTasks(ctx, "", "")
+	require.NoError(t, err)
+	assert.Len(t, tasks, 2)
+	assert.Equal(t, "t1", tasks[0].ID)
+	assert.Equal(t, "t3", tasks[1].ID)
+
+	tasks, err = client.GetTasks(ctx, "p1", "")
+	require.NoError(t, err)
+	require.Len(t, tasks, 1)
+	assert.Equal(t, "Open task", tasks[0].Content)
+
+	task, err := client.GetTask(ctx, "t2")
+	require.NoError(t, err)
+	assert.True(t, task.Checked)
+
+	projects, err := client.GetProjects(ctx)
+	require.NoError(t, err)
+	require.Len(t, projects, 1)
+	assert.Equal(t, "Inbox", projects[0].Name)
+
+	sections, err := client.GetSections(ctx, "p1")
+	require.NoError(t, err)
+	assert.Len(t, sections, 1)
+
+	labels, err := client.GetLabels(ctx)
+	require.NoError(t, err)
+	assert.Len(t, labels, 1)
+
+	comments, err := client.GetComments(ctx, "t1", "")
+	require.NoError(t, err)
+	require.Len(t, comments, 1)
+	assert.Equal(t, "A note", comments[0].Content)
+
+	// All reads were served by a single sync
+	assert.Equal(t, 1, syncs)
+}
+
+func TestMirroredClientFallsBackToREST(t *testing.T) {
+	syncs := 0
+	var restPaths []string
+	client := newMirroredMockClient(t, &syncs, func(req *http.Request) (*http.Response, error) {
+		restPaths = append(restPaths, strings.TrimPrefix(req.URL.Path, "/api/v1"))
+		if strings.HasSuffix(req.URL.Path, "/123456789") {
+			return MockResponse(200, MockTask()), nil
+		}
+		return MockResponse(200, MockPaginatedTasks([]Task{*MockTask()})), nil
+	})
+	ctx := context.Background()
+
+	// Filter queries are evaluated by Todoist
+	_, err := client.GetTasks(ctx, "", "today")
+	require.NoError(t, err)
+
+	// Objects missing from the mirror are requested directly
+	task, err := client.GetTask(ctx, "123456789")
+	require.NoError(t, err)
+	assert.Equal(t, "123456789", task.ID)
+
+	assert.Equal(t, []string{"/tasks/filter", "/tasks/123456789"}, restPaths)
+}
+
+func TestMirroredClientWritesMarkStale(t *testing.T) {
+	syncs := 0
+	client := newMirroredMockClient(t, &syncs, func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: 204, Body: io.NopCloser(strings.NewReader("")), Header: make(http.Header)}, nil
+	})
+	ctx := context.Background()
+
+	_, err := client.GetTasks(ctx, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 1, syncs)
+
+	// A write makes the next read sync again
+	require.NoError(t, client.CloseTask(ctx, "t1"))
+	_, err = client.GetTasks(ctx, "", "")
+	require.NoError(t, err)
+	assert.Equal(t, 2, syncs)
+
+	_, err = client.GetProjects(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, 2, syncs)
+}
+
+func TestMirrorSyncKeepsWriteRequestIDs(t *testing.T) {
+	// createTask creates a task in a project given by name, with the mirror synced
+	// beforehand or not, and returns the idempotency keys of the sync and write requests
+	createTask := func(fresh bool) (syncKeys, writeKeys []string) {
+		syncs := 0
+		tp := newMirroredMockToolProvider(t, &syncs, func(req *http.Request) (*http.Response, error) {
+			writeKeys = append(writeKeys, req.Header.Get(idempotencyKeyHeader))
+			return MockResponse(200, MockTask()), nil
+		})
+		client := tp.client.(*mirroredClient)
+		if fresh {
+			require.NoError(t, client.mirror.Refresh(context.Background(), time.Hour))
+		}
+
+		// Record the keys of the syncs made while the tool runs
+		transport := client.TodoistClient.(*Client).httpClient.Transport.(*MockHTTPClient)
+		do := transport.DoFunc
+		transport.DoFunc = func(req *http.Request) (*http.Response, error) {
+			if strings.HasSuffix(req.URL.Path, "/sync") {
+				syncKeys = append(syncKeys, req.Header.Get(idempotencyKeyHeader))
+			}
+			return do(req)
+		}
+
+		tool := tp.withRequestID(toolsets.NewTypedServerTool(tp.CreateTask(), tp.HandleCreateTask))
+		result, err := tool.Handler(context.Background(), MockCallToolRequest(map[string]interface{}{
+			"content":     "Task",
+			"projectName": "Inbox",
+			"requestId":   "op-1",
+		}))
+		require.NoError(t, err)
+		require.False(t, result.IsError, resultText(result))
+		return syncKeys, writeKeys
+	}
+
+	staleSyncs, staleWrites := createTask(false)
+	freshSyncs, freshWrites := createTask(true)
+
+	// Only the stale mirror synced, and the sync did not take the caller's key
+	require.Len(t, staleSyncs, 1)
+	assert.NotEqual(t, "op-1", staleSyncs[0])
+	assert.Empty(t, freshSyncs)
+
+	// The task is created with the same key either way, so a retried call is deduplicated
+	assert.Equal(t, []string{"op-1"}, staleWrites)
+	assert.Equal(t, staleWrites, freshWrites)
+}
@@ -10,6 +10,8 @@ import (
 	"net/http/httptest"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
+	"github.com/sirupsen/logrus"
 )
 
 // MockHTTPClient is a mock HTTP client for testing
@@ -169,10 +171,16 @@ func (m *MockToolProviderWithHandlers) HandleToolCall(ctx context.Context, toolN
 		content = `{"id":"123456789","content":"Test Task"}`
 	case "todoist_create_task":
 		content = `{"id":"123456789","content":"Test Task"}`
+	case "todoist_quick_add_task":
+		content = `{"task":{"id":"123456789","content":"Call mom","labels":["phone"],"priority":4}}`
 	case "todoist_update_task":
 		content = `{"id":"123456789","content":"Updated Task"}`
 	case "todoist_close_task":
 		content = `{"success":true}`
+	case "todoist_get_completed_tasks":
+		content = `{"tasks":[{"id":"123456789","content":"Test Task","checked":true,"completed_at":"2025-01-03T10:00:00Z"}]}`
+	case "todoist_reopen_task":
+		content = `{"task":{"id":"123456789","content":"Test Task","checked":false}}`
 	case "todoist_delete_task":
 		content = `{"success":true}`
 	case "todoist_get_projects":
@@ -199,3 +207,81 @@ func (m *MockToolProviderWithHandlers) HandleToolCall(ctx context.Context, toolN
 
 	return result, nil
 }
+
+// intPtr returns a pointer to the given int
+func intPtr(n int) *int {
+	return &n
+}
+
+// strPtr returns a pointer to the given string
+func strPtr(s string) *string {
+	return &s
+}
+
+// MockLabel returns a mock Label for testing (API v1 format)
+func MockLabel() *Label {
+	return &Label{
+		ID:         "2156154810",
+		Name:       "waiting",
+		Color:      "charcoal",
+		Order:      1,
+		IsFavorite: false,
+	}
+}
+
+// NewMockToolProviderWithClient creates a ToolProvider backed by a mock HTTP client,
+// so that tool handlers can be exercised end to end
+func NewMockToolProviderWithClient(doFunc func(req *http.Request) (*http.Response, error)) *ToolProvider {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+
+	return &ToolProvider{
+		client: NewMockClient(doFunc),
+		logger: logger,
+	}
+}
+
+// resultText returns the text of the first content item of a tool result
+func resultText(result *mcp.CallToolResult) string {
+	if result == nil || len(result.Content) == 0 {
+		return ""
+	}
+	if text, ok := result.Content[0].(*mcp.TextContent); ok {
+		return text.Text
+	}
+	return ""
+}
+
+// MockSection returns a mock Section for testing (API v1 format)
+func MockSection() *Section {
+	return &Section{
+		ID:           "7025",
+		ProjectID:    "987654321",
+		Name:         "In Progress",
+		SectionOrder: 1,
+	}
+}
+
+// MockComment returns a mock Comment with a file attachment for testing (API v1 format)
+func MockComment() *Comment {
+	taskID := "123456789"
+	return &Comment{
+		ID:        "2992679862",
+		PostedUID: "2671355",
+		ItemID:    &taskID,
+		Content:   "Need one bottle of milk",
+		FileAttachment: &FileAttachment{
+			ResourceType: "file",
+			FileName:     "File.pdf",
+			FileType:     "application/pdf",
+			FileURL:      "https://cdn-domain.tld/path/to/file.pdf",
+		},
+	}
+}
+
+// CallTypedTool calls a typed tool handler with the arguments of the request decoded into
+// its parameters, as the handler of a typed server tool does
+func CallTypedTool[In any](ctx context.Context, handler toolsets.TypedToolHandlerFunc[In], request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	tool := mcp.Tool{Name: "test", InputSchema: map[string]interface{}{"type": "object"}}
+	return toolsets.NewTypedServerTool(tool, handler).Handler(ctx, request)
+}
@@ -1,18 +1,46 @@
 package todoist
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // TodoistClient defines the interface for Todoist API operations
 type TodoistClient interface {
 	GetTasks(ctx context.Context, projectID, filter string) ([]Task, error)
 	GetTask(ctx context.Context, id string) (*Task, error)
+	GetCompletedTasks(ctx context.Context, req CompletedTasksRequest) (*CompletedTasksPage, error)
 	GetProjects(ctx context.Context) ([]Project, error)
 	GetProject(ctx context.Context, id string) (*Project, error)
+	CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error)
+	UpdateProject(ctx context.Context, id string, req UpdateProjectRequest) (*Project, error)
+	ArchiveProject(ctx context.Context, id string) (*Project, error)
+	UnarchiveProject(ctx context.Context, id string) (*Project, error)
+	DeleteProject(ctx context.Context, id string) error
 	CreateTask(ctx context.Context, req CreateTaskRequest) (*Task, error)
+	QuickAddTask(ctx context.Context, req QuickAddTaskRequest) (*Task, error)
 	UpdateTask(ctx context.Context, id string, req UpdateTaskRequest) (*Task, error)
 	CloseTask(ctx context.Context, id string) error
 	ReopenTask(ctx context.Context, id string) error
 	DeleteTask(ctx context.Context, id string) error
+	GetLabels(ctx context.Context) ([]Label, error)
+	GetLabel(ctx context.Context, id string) (*Label, error)
+	CreateLabel(ctx context.Context, req CreateLabelRequest) (*Label, error)
+	UpdateLabel(ctx context.Context, id string, req UpdateLabelRequest) (*Label, error)
+	DeleteLabel(ctx context.Context, id string) error
+	RenameSharedLabel(ctx context.Context, name, newName string) error
+	GetSections(ctx context.Context, projectID string) ([]Section, error)
+	GetSection(ctx context.Context, id string) (*Section, error)
+	CreateSection(ctx context.Context, req CreateSectionRequest) (*Section, error)
+	UpdateSection(ctx context.Context, id string, req UpdateSectionRequest) (*Section, error)
+	ReorderSections(ctx context.Context, orders []SectionOrder) error
+	DeleteSection(ctx context.Context, id string) error
+	MoveTask(ctx context.Context, id string, req MoveTaskRequest) (*Task, error)
+	GetComments(ctx context.Context, taskID, projectID string) ([]Comment, error)
+	CreateComment(ctx context.Context, req CreateCommentRequest) (*Comment, error)
+	UpdateComment(ctx context.Context, id string, req UpdateCommentRequest) (*Comment, error)
+	DeleteComment(ctx context.Context, id string) error
+	ExecuteBatch(ctx context.Context, commands []BatchCommand) (*BatchResult, error)
 }
 
 // PaginatedResponse is a generic paginated response from the Todoist API v1
@@ -21,6 +49,12 @@ type PaginatedResponse[T any] struct {
 	NextCursor *string `json:"next_cursor"`
 }
 
+// CompletedTasksPage is a page of completed tasks from the /tasks/completed endpoints
+type CompletedTasksPage struct {
+	Items      []Task  `json:"items"`
+	NextCursor *string `json:"next_cursor"`
+}
+
 // Task represents a Todoist task (API v1)
 type Task struct {
 	ID             string    `json:"id"`
@@ -93,11 +127,71 @@ type Project struct {
 	InboxProject   bool    `json:"inbox_project"`
 }
 
+// Section represents a Todoist project section (API v1)
+type Section struct {
+	ID           string  `json:"id"`
+	UserID       string  `json:"user_id"`
+	ProjectID    string  `json:"project_id"`
+	Name         string  `json:"name"`
+	SectionOrder int     `json:"section_order"`
+	IsArchived   bool    `json:"is_archived"`
+	IsDeleted    bool    `json:"is_deleted"`
+	IsCollapsed  bool    `json:"is_collapsed"`
+	AddedAt      *string `json:"added_at"`
+	UpdatedAt    *string `json:"updated_at"`
+	ArchivedAt   *string `json:"archived_at"`
+}
+
+// Label represents a Todoist personal label (API v1)
+type Label struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	Color      string `json:"color"`
+	Order      int    `json:"order"`
+	IsFavorite bool   `json:"is_favorite"`
+}
+
+// Comment represents a comment on a task or project (API v1).
+// Exactly one of ItemID (the task ID) and ProjectID is set.
+type Comment struct {
+	ID             string          `json:"id"`
+	PostedUID      string          `json:"posted_uid"`
+	ItemID         *string         `json:"item_id"`
+	ProjectID      *string         `json:"project_id"`
+	Content        string          `json:"content"`
+	FileAttachment *FileAttachment `json:"file_attachment"`
+	UIDsToNotify   []string        `json:"uids_to_notify"`
+	IsDeleted      bool            `json:"is_deleted"`
+	PostedAt       *string         `json:"posted_at"`
+}
+
+// FileAttachment represents a file or link attached to a comment
+type FileAttachment struct {
+	ResourceType string `json:"resource_type,omitempty"`
+	FileName     string `json:"file_name,omitempty"`
+	FileSize     int    `json:"file_size,omitempty"`
+	FileType     string `json:"file_type,omitempty"`
+	FileURL      string `json:"file_url,omitempty"`
+	UploadState  string `json:"upload_state,omitempty"`
+	Image        string `json:"image,omitempty"`
+	Title        string `json:"title,omitempty"`
+	URL          string `json:"url,omitempty"`
+}
+
+// CompletedTasksRequest represents the query for tasks completed within a time range
+type CompletedTasksRequest struct {
+	Since     time.Time
+	Until     time.Time
+	ProjectID string
+	Cursor    string
+}
+
 // CreateTaskRequest represents the request to create a task
 type CreateTaskRequest struct {
 	Content     string `json:"content"`
 	Description string `json:"description,omitempty"`
 	ProjectID   string `json:"project_id,omitempty"`
+	SectionID   string `json:"section_id,omitempty"`
 	ParentID    string `json:"parent_id,omitempty"`
 	Order       int    `json:"order,omitempty"`
 	Priority    int    `json:"priority,omitempty"`
@@ -106,18 +200,138 @@ type CreateTaskRequest struct {
 	DueDatetime string `json:"due_datetime,omitempty"`
 }
 
-// UpdateTaskRequest represents the request to update a task
+// QuickAddTaskRequest represents the request to create a task with Quick Add
+type QuickAddTaskRequest struct {
+	Text         string `json:"text"`
+	Note         string `json:"note,omitempty"`
+	Reminder     string `json:"reminder,omitempty"`
+	AutoReminder bool   `json:"auto_reminder,omitempty"`
+}
+
+// UpdateTaskRequest represents the request to update a task.
+// Pointer and Nullable fields are omitted when unset, so that an empty description,
+// an empty label list or a null value can be sent to clear the field.
 type UpdateTaskRequest struct {
-	Content     string `json:"content,omitempty"`
+	Content      string           `json:"content,omitempty"`
+	Description  *string          `json:"description,omitempty"`
+	Labels       *[]string        `json:"labels,omitempty"`
+	Priority     int              `json:"priority,omitempty"`
+	DueString    string           `json:"due_string,omitempty"`
+	DueDate      string           `json:"due_date,omitempty"`
+	DueDatetime  string           `json:"due_datetime,omitempty"`
+	AssigneeID   Nullable[string] `json:"assignee_id,omitzero"`
+	Duration     Nullable[int]    `json:"duration,omitzero"`
+	DurationUnit Nullable[string] `json:"duration_unit,omitzero"`
+	DeadlineDate Nullable[string] `json:"deadline_date,omitzero"`
+}
+
+// NoDueDate is the due string that removes the due date of a task
+const NoDueDate = "no date"
+
+// CreateProjectRequest represents the request to create a project
+type CreateProjectRequest struct {
+	Name        string `json:"name"`
 	Description string `json:"description,omitempty"`
-	Priority    int    `json:"priority,omitempty"`
-	DueString   string `json:"due_string,omitempty"`
-	DueDate     string `json:"due_date,omitempty"`
-	DueDatetime string `json:"due_datetime,omitempty"`
+	ParentID    string `json:"parent_id,omitempty"`
+	Color       string `json:"color,omitempty"`
+	IsFavorite  bool   `json:"is_favorite,omitempty"`
+	ViewStyle   string `json:"view_style,omitempty"`
+}
+
+// UpdateProjectRequest represents the request to update a project
+type UpdateProjectRequest struct {
+	Name        string `json:"name,omitempty"`
+	Description string `json:"description,omitempty"`
+	Color       string `json:"color,omitempty"`
+	IsFavorite  *bool  `json:"is_favorite,omitempty"`
+	ViewStyle   string `json:"view_style,omitempty"`
+}
+
+// MoveTaskRequest represents the request to move a task.
+// Exactly one of the destination fields should be set.
+type MoveTaskRequest struct {
+	ProjectID string `json:"project_id,omitempty"`
+	SectionID string `json:"section_id,omitempty"`
+	ParentID  string `json:"parent_id,omitempty"`
+}
+
+// CreateSectionRequest represents the request to create a section
+type CreateSectionRequest struct {
+	Name      string `json:"name"`
+	ProjectID string `json:"project_id"`
+	Order     *int   `json:"order,omitempty"`
+}
+
+// UpdateSectionRequest represents the request to update a section
+type UpdateSectionRequest struct {
+	Name string `json:"name"`
+}
+
+// SectionOrder assigns a position to a section within its project
+type SectionOrder struct {
+	ID           string `json:"id"`
+	SectionOrder int    `json:"section_order"`
+}
+
+// BatchCommand is a write command submitted to the Sync API as part of a batch.
+// Commands that create an object set TempID; later commands of the same batch may use
+// the temporary ID wherever they refer to the new object's ID.
+type BatchCommand struct {
+	Type   string                 `json:"type"`
+	UUID   string                 `json:"uuid"`
+	TempID string                 `json:"temp_id,omitempty"`
+	Args   map[string]interface{} `json:"args"`
+}
+
+// BatchResult is the outcome of a batch of Sync commands
+type BatchResult struct {
+	// Errors maps the UUID of each failed command to its error; all other commands succeeded
+	Errors map[string]error
+	// TempIDMapping maps the temporary IDs of created objects to their real IDs
+	TempIDMapping map[string]string
+}
+
+// CreateCommentRequest represents the request to add a comment.
+// Exactly one of TaskID and ProjectID must be set.
+type CreateCommentRequest struct {
+	Content    string          `json:"content"`
+	TaskID     string          `json:"task_id,omitempty"`
+	ProjectID  string          `json:"project_id,omitempty"`
+	Attachment *FileAttachment `json:"attachment,omitempty"`
+}
+
+// UpdateCommentRequest represents the request to update a comment
+type UpdateCommentRequest struct {
+	Content string `json:"content"`
+}
+
+// CreateLabelRequest represents the request to create a personal label
+type CreateLabelRequest struct {
+	Name       string `json:"name"`
+	Color      string `json:"color,omitempty"`
+	Order      *int   `json:"order,omitempty"`
+	IsFavorite bool   `json:"is_favorite,omitempty"`
+}
+
+// UpdateLabelRequest represents the request to update a personal label
+type UpdateLabelRequest struct {
+	Name       string `json:"name,omitempty"`
+	Color      string `json:"color,omitempty"`
+	Order      *int   `json:"order,omitempty"`
+	IsFavorite *bool  `json:"is_favorite,omitempty"`
+}
+
+// RenameSharedLabelRequest represents the request to rename a shared label
+type RenameSharedLabelRequest struct {
+	Name    string `json:"name"`
+	NewName string `json:"new_name"`
 }
 
 // ErrorResponse represents an error response
 type ErrorResponse struct {
-	Error string `json:"error"`
-	Code  int    `json:"code"`
+	Error     string `json:"error"`
+	Code      int    `json:"code"`
+	ErrorCode int    `json:"error_code"`
+	ErrorTag  string `json:"error_tag"`
+	HTTPCode  int    `json:"http_code"`
 }
@@ -0,0 +1,59 @@
+package todoist
+
+import "encoding/json"
+
+// Nullable is an optional request field that distinguishes between leaving a value
+// unchanged (the zero Nullable, omitted with the omitzero tag option), setting it,
+// and clearing it (sent as JSON null).
+type Nullable[T any] struct {
+	value T
+	set   bool
+	null  bool
+}
+
+// NewNullable returns a Nullable that sets the field to v
+func NewNullable[T any](v T) Nullable[T] {
+	return Nullable[T]{value: v, set: true}
+}
+
+// NullValue returns a Nullable that clears the field
+func NullValue[T any]() Nullable[T] {
+	return Nullable[T]{set: true, null: true}
+}
+
+// IsZero reports whether the field is left unchanged
+func (n Nullable[T]) IsZero() bool {
+	return !n.set
+}
+
+// IsNull reports whether the field is cleared
+func (n Nullable[T]) IsNull() bool {
+	return n.set && n.null
+}
+
+// Value returns the value the field is set to and whether it is set to a value
+func (n Nullable[T]) Value() (T, bool) {
+	return n.value, n.set && !n.null
+}
+
+// MarshalJSON implements json.Marshaler
+func (n Nullable[T]) MarshalJSON() ([]byte, error) {
+	if !n.set || n.null {
+		return []byte("null"), nil
+	}
+	return json.Marshal(n.value)
+}
+
+// UnmarshalJSON implements json.Unmarshaler
+func (n *Nullable[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*n = NullValue[T]()
+		return nil
+	}
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*n = NewNullable(v)
+	return nil
+}
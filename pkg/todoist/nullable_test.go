@@ -0,0 +1,39 @@
+package todoist
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNullableMarshal(t *testing.T) {
+	req := UpdateTaskRequest{
+		Content:      "Task",
+		AssigneeID:   NullValue[string](),
+		DeadlineDate: NewNullable("2025-12-31"),
+	}
+
+	data, err := json.Marshal(req)
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"content":"Task","assignee_id":null,"deadline_date":"2025-12-31"}`, string(data))
+}
+
+func TestNullableUnmarshal(t *testing.T) {
+	var v struct {
+		Cleared Nullable[string] `json:"cleared"`
+		Set     Nullable[int]    `json:"set"`
+		Missing Nullable[string] `json:"missing"`
+	}
+	assert.NoError(t, json.Unmarshal([]byte(`{"cleared":null,"set":15}`), &v))
+
+	assert.True(t, v.Cleared.IsNull())
+
+	value, ok := v.Set.Value()
+	assert.True(t, ok)
+	assert.Equal(t, 15, value)
+
+	assert.True(t, v.Missing.IsZero())
+	_, ok = v.Missing.Value()
+	assert.False(t, ok)
+}
@@ -0,0 +1,97 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+	"strconv"
+)
+
+// paginate returns an iterator over every item of a paginated list endpoint.
+// It requests pages of c.pageSize items, follows next_cursor until the API
+// reports no further pages, and stops early once c.maxItems items have been
+// yielded. Errors are yielded once and end the iteration.
+func paginate[T any](ctx context.Context, c *Client, endpoint string, params url.Values, action string) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		var zero T
+		count := 0
+		cursor := ""
+
+		for {
+			page, err := fetchPage[T](ctx, c, endpoint, params, cursor, action)
+			if err != nil {
+				yield(zero, err)
+				return
+			}
+
+			for _, item := range page.Results {
+				if c.maxItems > 0 && count >= c.maxItems {
+					return
+				}
+				if !yield(item, nil) {
+					return
+				}
+				count++
+			}
+
+			// A full cap needs no further page
+			if c.maxItems > 0 && count >= c.maxItems {
+				return
+			}
+			if page.NextCursor == nil || *page.NextCursor == "" || *page.NextCursor == cursor {
+				return
+			}
+			cursor = *page.NextCursor
+		}
+	}
+}
+
+// fetchPage retrieves a single page of a paginated list endpoint
+func fetchPage[T any](ctx context.Context, c *Client, endpoint string, params url.Values, cursor, action string) (*PaginatedResponse[T], error) {
+	q := url.Values{}
+	for key, values := range params {
+		q[key] = values
+	}
+	if c.pageSize > 0 {
+		q.Set("limit", strconv.Itoa(c.pageSize))
+	}
+	if cursor != "" {
+		q.Set("cursor", cursor)
+	}
+	if len(q) > 0 {
+		endpoint = endpoint + "?" + q.Encode()
+	}
+
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to %s: %w", action, err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse paginated response
+	var page PaginatedResponse[T]
+	if err := json.Unmarshal(bodyBytes, &page); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &page, nil
+}
+
+// collect drains an iterator into a slice, returning the first error encountered
+func collect[T any](seq iter.Seq2[T, error]) ([]T, error) {
+	items := []T{}
+	for item, err := range seq {
+		if err != nil {
+			return nil, err
+		}
+		items = append(items, item)
+	}
+	return items, nil
+}
@@ -0,0 +1,145 @@
+package todoist
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// minPriority and maxPriority are the range of task priorities, on either scale
+const (
+	minPriority = 1
+	maxPriority = 4
+)
+
+// Priority scales of the priorityScale parameter. The Todoist API counts priorities from
+// 1 (normal) to 4 (urgent), while the app shows them the other way round, from p4 to p1.
+const (
+	priorityScaleAPI = "api"
+	priorityScaleApp = "app"
+)
+
+// Priority is the priority of a task as given to a tool: an integer, read on the scale
+// chosen by priorityScale, or a label from "p1" (urgent) to "p4" (normal) as in the app.
+// An integer may also be given as a string holding one, such as "4".
+type Priority struct {
+	value int
+	label bool
+}
+
+// UnmarshalJSON decodes a priority from a number, a numeric string or a "p1" to "p4"
+// label, and checks its range
+func (p *Priority) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	label := false
+	if s, ok := v.(string); ok {
+		s = strings.TrimSpace(s)
+		if len(s) > 1 && (s[0] == 'p' || s[0] == 'P') {
+			v = s[1:]
+			label = true
+		}
+	}
+
+	n, err := intValue(v)
+	if err != nil {
+		return fmt.Errorf("priority must be an integer or a label from p1 to p4, got %s", data)
+	}
+	if n < minPriority || n > maxPriority {
+		return fmt.Errorf("priority must be between %d and %d, got %s", minPriority, maxPriority, data)
+	}
+	*p = Priority{value: n, label: label}
+	return nil
+}
+
+// APIValue returns the priority on the scale of the Todoist API, where 4 is urgent,
+// reading an integer priority on the given scale. It returns 0 if no priority was given.
+func (p Priority) APIValue(scale string) int {
+	if p.value == 0 {
+		return 0
+	}
+	if p.label || scale == priorityScaleApp {
+		return maxPriority + 1 - p.value
+	}
+	return p.value
+}
+
+// Integer is an integer argument of a typed tool, which may also be given as a string
+// holding one, such as "3"
+type Integer int
+
+// UnmarshalJSON decodes an integer from a number or a numeric string
+func (i *Integer) UnmarshalJSON(data []byte) error {
+	var v interface{}
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	n, err := intValue(v)
+	if err != nil {
+		return fmt.Errorf("value %w", err)
+	}
+	*i = Integer(n)
+	return nil
+}
+
+// paramValue converts the value of parameter p to T. JSON numbers are decoded as float64,
+// so int and float64 parameters accept any number, or a string holding one.
+func paramValue[T any](p string, v interface{}) (T, error) {
+	var zero T
+	if t, ok := v.(T); ok {
+		return t, nil
+	}
+
+	var converted interface{}
+	var err error
+	switch any(zero).(type) {
+	case int:
+		converted, err = intValue(v)
+	case float64:
+		converted, err = numberValue(v)
+	default:
+		return zero, fmt.Errorf("parameter %s is not of the expected type", p)
+	}
+	if err != nil {
+		return zero, fmt.Errorf("parameter %s %w", p, err)
+	}
+	return converted.(T), nil
+}
+
+// numberValue returns a JSON number, or a string holding one, as a float64
+func numberValue(v interface{}) (float64, error) {
+	switch n := v.(type) {
+	case float64:
+		return n, nil
+	case int:
+		return float64(n), nil
+	case json.Number:
+		return n.Float64()
+	case string:
+		f, err := strconv.ParseFloat(strings.TrimSpace(n), 64)
+		if err != nil || math.IsNaN(f) || math.IsInf(f, 0) {
+			return 0, fmt.Errorf("must be a number, got %q", n)
+		}
+		return f, nil
+	default:
+		return 0, fmt.Errorf("must be a number")
+	}
+}
+
+// intValue returns a JSON number, or a string holding one, as an int.
+// Numbers with a fractional part are rejected rather than truncated.
+func intValue(v interface{}) (int, error) {
+	f, err := numberValue(v)
+	if err != nil {
+		return 0, err
+	}
+	if f != math.Trunc(f) || f < math.MinInt32 || f > math.MaxInt32 {
+		return 0, fmt.Errorf("must be an integer, got %v", v)
+	}
+	return int(f), nil
+}
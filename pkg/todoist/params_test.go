@@ -0,0 +1,166 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOptionalIntParam(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   interface{}
+		want    int
+		wantErr string
+	}{
+		{name: "JSON number", value: 3, want: 3},
+		{name: "integral float", value: 3.0, want: 3},
+		{name: "numeric string", value: "3", want: 3},
+		{name: "numeric string with spaces", value: " 42 ", want: 42},
+		{name: "negative number", value: -1, want: -1},
+		{name: "fraction", value: 2.5, wantErr: "parameter order must be an integer"},
+		{name: "non-numeric string", value: "high", wantErr: "parameter order must be a number"},
+		{name: "boolean", value: true, wantErr: "parameter order must be a number"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := OptionalParam[int](MockCallToolRequest(map[string]interface{}{"order": tt.value}), "order")
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNumericParamHelpers(t *testing.T) {
+	f, err := RequiredParam[float64](MockCallToolRequest(map[string]interface{}{"order": "1.5"}), "order")
+	assert.NoError(t, err)
+	assert.Equal(t, 1.5, f)
+
+	n, err := OptionalPtrParam[int](MockCallToolRequest(map[string]interface{}{"order": "7"}), "order")
+	require.NoError(t, err)
+	assert.Equal(t, 7, *n)
+
+	_, err = RequiredParam[int](MockCallToolRequest(map[string]interface{}{"order": "first"}), "order")
+	assert.Error(t, err)
+}
+
+func TestPriorityUnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		json    string
+		wantAPI int // on the API scale
+		wantApp int // on the app scale
+		wantErr string
+	}{
+		{json: `1`, wantAPI: 1, wantApp: 4},
+		{json: `4`, wantAPI: 4, wantApp: 1},
+		{json: `4.0`, wantAPI: 4, wantApp: 1},
+		{json: `"2"`, wantAPI: 2, wantApp: 3},
+		{json: `"p1"`, wantAPI: 4, wantApp: 4},
+		{json: `"P4"`, wantAPI: 1, wantApp: 1},
+		{json: `" p2 "`, wantAPI: 3, wantApp: 3},
+		{json: `0`, wantErr: "priority must be between 1 and 4, got 0"},
+		{json: `5`, wantErr: "priority must be between 1 and 4, got 5"},
+		{json: `"5"`, wantErr: `priority must be between 1 and 4, got "5"`},
+		{json: `"p5"`, wantErr: `priority must be between 1 and 4, got "p5"`},
+		{json: `2.5`, wantErr: "priority must be an integer or a label from p1 to p4"},
+		{json: `"urgent"`, wantErr: "priority must be an integer or a label from p1 to p4"},
+		{json: `"p"`, wantErr: "priority must be an integer or a label from p1 to p4"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.json, func(t *testing.T) {
+			var p Priority
+			err := json.Unmarshal([]byte(tt.json), &p)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantAPI, p.APIValue(priorityScaleAPI))
+			assert.Equal(t, tt.wantAPI, p.APIValue(""))
+			assert.Equal(t, tt.wantApp, p.APIValue(priorityScaleApp))
+		})
+	}
+
+	// No priority given
+	assert.Equal(t, 0, Priority{}.APIValue(priorityScaleApp))
+}
+
+func TestCreateTaskPriority(t *testing.T) {
+	var body CreateTaskRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockTask()), nil
+	})
+
+	// A numeric string is accepted
+	result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(map[string]interface{}{
+		"content":  "Pay rent",
+		"priority": "4",
+	}))
+	require.NoError(t, err)
+	assert.False(t, result.IsError, resultText(result))
+	assert.Equal(t, 4, body.Priority)
+
+	// An out-of-range priority is rejected instead of being dropped
+	body = CreateTaskRequest{}
+	_, err = CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(map[string]interface{}{
+		"content":  "Pay rent",
+		"priority": 5,
+	}))
+	assert.ErrorContains(t, err, "priority must be between 1 and 4")
+	assert.Empty(t, body.Content)
+}
+
+func TestCreateTaskPriorityScale(t *testing.T) {
+	var bodies []CreateTaskRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		var body CreateTaskRequest
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		bodies = append(bodies, body)
+		return MockResponse(200, MockTask()), nil
+	})
+
+	tests := []struct {
+		name string
+		args map[string]interface{}
+		want []int // the API priorities of the task and its subtask
+	}{
+		{
+			name: "API scale by default",
+			args: map[string]interface{}{"priority": 4, "subtasks": []interface{}{map[string]interface{}{"content": "Sub", "priority": 2}}},
+			want: []int{4, 2},
+		},
+		{
+			name: "app scale",
+			args: map[string]interface{}{"priority": 1, "priorityScale": "app", "subtasks": []interface{}{map[string]interface{}{"content": "Sub", "priority": 2}}},
+			want: []int{4, 3},
+		},
+		{
+			name: "labels on either scale",
+			args: map[string]interface{}{"priority": "p1", "priorityScale": "api", "subtasks": []interface{}{map[string]interface{}{"content": "Sub", "priority": "p3"}}},
+			want: []int{4, 2},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			bodies = nil
+			tt.args["content"] = "Task"
+			result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(tt.args))
+			require.NoError(t, err)
+			require.False(t, result.IsError, resultText(result))
+			require.Len(t, bodies, 2)
+			assert.Equal(t, tt.want, []int{bodies[0].Priority, bodies[1].Priority})
+		})
+	}
+}
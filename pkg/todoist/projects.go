@@ -3,7 +3,6 @@ package todoist
 import (
 	"context"
 	"encoding/json"
-	"fmt"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
@@ -13,9 +12,12 @@ type GetProjectsResponse struct {
 	Projects []Project `json:"projects"`
 }
 
+// GetProjectsParams represents the parameters for the todoist_get_projects tool
+type GetProjectsParams struct{}
+
 // GetProjectParams represents the parameters for the todoist_get_project tool
 type GetProjectParams struct {
-	ID string `json:"id"`
+	ID string `json:"id" jsonschema:"The unique identifier of the project to retrieve. Specify the numeric Todoist project ID (e.g., '2203306141')."`
 }
 
 // GetProjectResponse represents the response from the todoist_get_project tool
@@ -23,14 +25,67 @@ type GetProjectResponse struct {
 	Project Project `json:"project"`
 }
 
+// CreateProjectParams represents the parameters for the todoist_create_project tool
+type CreateProjectParams struct {
+	Name        string `json:"name" jsonschema:"The name of the project (required)."`
+	Description string `json:"description,omitempty" jsonschema:"Description of the project. Supports Markdown formatting."`
+	ParentID    string `json:"parentId,omitempty" jsonschema:"Parent project ID for creating a sub-project. The project will be nested under this project."`
+	Color       string `json:"color,omitempty" jsonschema:"The color of the project, as a Todoist color name (e.g., 'berry_red', 'blue', 'grey')."`
+	IsFavorite  bool   `json:"isFavorite,omitempty" jsonschema:"Whether the project is marked as a favorite."`
+	ViewStyle   string `json:"viewStyle,omitempty" jsonschema:"How the project is displayed in the Todoist app."`
+}
+
+// CreateProjectResponse represents the response from the todoist_create_project tool
+type CreateProjectResponse struct {
+	Project Project `json:"project"`
+}
+
+// UpdateProjectParams represents the parameters for the todoist_update_project tool
+type UpdateProjectParams struct {
+	ID          string `json:"id" jsonschema:"The unique identifier of the project to update (required). Specify the numeric Todoist project ID (e.g., '2203306141')."`
+	Name        string `json:"name,omitempty" jsonschema:"The new name of the project."`
+	Description string `json:"description,omitempty" jsonschema:"Description of the project. Supports Markdown formatting."`
+	Color       string `json:"color,omitempty" jsonschema:"The new color of the project, as a Todoist color name (e.g., 'berry_red', 'blue', 'grey')."`
+	IsFavorite  *bool  `json:"isFavorite,omitempty" jsonschema:"Whether the project is marked as a favorite."`
+	ViewStyle   string `json:"viewStyle,omitempty" jsonschema:"How the project is displayed in the Todoist app."`
+}
+
+// UpdateProjectResponse represents the response from the todoist_update_project tool
+type UpdateProjectResponse struct {
+	Project Project `json:"project"`
+}
+
+// ArchiveProjectParams represents the parameters for the todoist_archive_project tool
+type ArchiveProjectParams struct {
+	ID string `json:"id" jsonschema:"The unique identifier of the project to archive (required). Specify the numeric Todoist project ID (e.g., '2203306141'). Sub-projects are archived too."`
+}
+
+// UnarchiveProjectParams represents the parameters for the todoist_unarchive_project tool
+type UnarchiveProjectParams struct {
+	ID string `json:"id" jsonschema:"The unique identifier of the archived project to restore (required). Specify the numeric Todoist project ID (e.g., '2203306141')."`
+}
+
+// ArchiveProjectResponse represents the response from the todoist_archive_project and todoist_unarchive_project tools
+type ArchiveProjectResponse struct {
+	Project Project `json:"project"`
+}
+
+// DeleteProjectParams represents the parameters for the todoist_delete_project tool
+type DeleteProjectParams struct {
+	ID string `json:"id" jsonschema:"The unique identifier of the project to delete (required). Specify the numeric Todoist project ID (e.g., '2203306141'). Warning: All tasks and sub-projects are deleted too. This action is permanent and cannot be undone; prefer todoist_archive_project for finished projects."`
+}
+
 // GetProjects returns the todoist_get_projects tool
 func (tp *ToolProvider) GetProjects() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":       "object",
-		"properties": map[string]interface{}{},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[GetProjectsParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
+	addFormatProperties(inputSchema, projectRenderer)
+
 	// Convert the input schema to JSON
 	inputSchemaJSON, err := json.Marshal(inputSchema)
 	if err != nil {
@@ -38,16 +93,30 @@ func (tp *ToolProvider) GetProjects() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetProjectsResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_get_projects",
-		Description: "Get a list of projects.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
-		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		Name:         "todoist_get_projects",
+		Description:  "Get a list of projects.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
 	}
 }
 
 // HandleGetProjects handles the todoist_get_projects tool request
-func (tp *ToolProvider) HandleGetProjects(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (tp *ToolProvider) HandleGetProjects(ctx context.Context, request *mcp.CallToolRequest, params GetProjectsParams) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, projectRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
 	// Log the request
 	tp.logger.Info("Getting projects")
 
@@ -55,36 +124,29 @@ func (tp *ToolProvider) HandleGetProjects(ctx context.Context, request *mcp.Call
 	projects, err := tp.client.GetProjects(ctx)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to get projects")
-		return newToolResultError("Failed to get projects", err), nil
+		return newToolResultAPIError("Failed to get projects", err, "projects", ""), nil
 	}
 
 	// Convert projects to JSON
 	response := GetProjectsResponse{
 		Projects: projects,
 	}
-	responseJSON, err := json.Marshal(response)
-	if err != nil {
-		return newToolResultError("Failed to marshal response", err), nil
-	}
 
-	// Return the response
-	return newToolResultText(string(responseJSON)), nil
+	// Return the response in the requested format
+	return renderList(format, projectRenderer, response, "projects", projects, nil), nil
 }
 
 // GetProject returns the todoist_get_project tool
 func (tp *ToolProvider) GetProject() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":     "object",
-		"required": []string{"id"},
-		"properties": map[string]interface{}{
-			"id": map[string]interface{}{
-				"type":        "string",
-				"description": "The unique identifier of the project to retrieve. Specify the numeric Todoist project ID (e.g., '2203306141').",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[GetProjectParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
+	addFormatProperties(inputSchema, projectRenderer)
+
 	// Convert the input schema to JSON
 	inputSchemaJSON, err := json.Marshal(inputSchema)
 	if err != nil {
@@ -92,22 +154,32 @@ func (tp *ToolProvider) GetProject() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetProjectResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_get_project",
-		Description: "Get a project by ID.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
-		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		Name:         "todoist_get_project",
+		Description:  "Get a project by ID.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
 	}
 }
 
 // HandleGetProject handles the todoist_get_project tool request
-func (tp *ToolProvider) HandleGetProject(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (tp *ToolProvider) HandleGetProject(ctx context.Context, request *mcp.CallToolRequest, params GetProjectParams) (*mcp.CallToolResult, error) {
 	// Parse parameters
-	id, err := RequiredParam[string](request, "id")
+	format, err := parseFormat(request, projectRenderer)
 	if err != nil {
-		return newToolResultError(fmt.Sprintf("Invalid parameter: %s", err.Error()), err), nil
+		return newToolResultError("Invalid format parameters", err), nil
 	}
 
+	id := params.ID
+
 	// Log the request
 	tp.logger.WithField("id", id).Info("Getting project")
 
@@ -115,18 +187,318 @@ func (tp *ToolProvider) HandleGetProject(ctx context.Context, request *mcp.CallT
 	project, err := tp.client.GetProject(ctx, id)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to get project")
-		return newToolResultError("Failed to get project", err), nil
+		return newToolResultAPIError("Failed to get project", err, "project", id), nil
 	}
 
 	// Convert project to JSON
 	response := GetProjectResponse{
 		Project: *project,
 	}
-	responseJSON, err := json.Marshal(response)
+
+	// Return the response in the requested format
+	return renderObject(format, projectRenderer, response, "project", *project), nil
+}
+
+// CreateProject returns the todoist_create_project tool
+func (tp *ToolProvider) CreateProject() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[CreateProjectParams](map[string]map[string]interface{}{
+		"viewStyle": {"enum": []string{"list", "board", "calendar"}},
+	})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[CreateProjectResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_create_project",
+		Description:  "Create a new project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleCreateProject handles the todoist_create_project tool request
+func (tp *ToolProvider) HandleCreateProject(ctx context.Context, request *mcp.CallToolRequest, params CreateProjectParams) (*mcp.CallToolResult, error) {
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"name":      params.Name,
+		"parentId":  params.ParentID,
+		"viewStyle": params.ViewStyle,
+	}).Info("Creating project")
+
+	// Create request
+	createReq := CreateProjectRequest{
+		Name:        params.Name,
+		Description: params.Description,
+		ParentID:    params.ParentID,
+		Color:       params.Color,
+		IsFavorite:  params.IsFavorite,
+		ViewStyle:   params.ViewStyle,
+	}
+
+	// Call the Todoist API
+	project, err := tp.client.CreateProject(ctx, createReq)
 	if err != nil {
-		return newToolResultError("Failed to marshal response", err), nil
+		tp.logger.WithError(err).Error("Failed to create project")
+		return newToolResultAPIError("Failed to create project", err, namedResource("parent project", params.ParentID), params.ParentID), nil
 	}
 
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Convert project to JSON
+	response := CreateProjectResponse{
+		Project: *project,
+	}
 	// Return the response
-	return newToolResultText(string(responseJSON)), nil
+	return jsonResult(response), nil
+}
+
+// UpdateProject returns the todoist_update_project tool
+func (tp *ToolProvider) UpdateProject() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[UpdateProjectParams](map[string]map[string]interface{}{
+		"viewStyle": {"enum": []string{"list", "board", "calendar"}},
+	})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[UpdateProjectResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_update_project",
+		Description:  "Update an existing project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleUpdateProject handles the todoist_update_project tool request
+func (tp *ToolProvider) HandleUpdateProject(ctx context.Context, request *mcp.CallToolRequest, params UpdateProjectParams) (*mcp.CallToolResult, error) {
+	id := params.ID
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"id":   id,
+		"name": params.Name,
+	}).Info("Updating project")
+
+	// Create request
+	updateReq := UpdateProjectRequest{
+		Name:        params.Name,
+		Description: params.Description,
+		Color:       params.Color,
+		IsFavorite:  params.IsFavorite,
+		ViewStyle:   params.ViewStyle,
+	}
+
+	// Call the Todoist API
+	project, err := tp.client.UpdateProject(ctx, id, updateReq)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to update project")
+		return newToolResultAPIError("Failed to update project", err, "project", id), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Convert project to JSON
+	response := UpdateProjectResponse{
+		Project: *project,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// ArchiveProject returns the todoist_archive_project tool
+func (tp *ToolProvider) ArchiveProject() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[ArchiveProjectParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[ArchiveProjectResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_archive_project",
+		Description:  "Archive a project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleArchiveProject handles the todoist_archive_project tool request
+func (tp *ToolProvider) HandleArchiveProject(ctx context.Context, request *mcp.CallToolRequest, params ArchiveProjectParams) (*mcp.CallToolResult, error) {
+	id := params.ID
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Archiving project")
+
+	// Call the Todoist API
+	project, err := tp.client.ArchiveProject(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to archive project")
+		return newToolResultAPIError("Failed to archive project", err, "project", id), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Convert project to JSON
+	response := ArchiveProjectResponse{
+		Project: *project,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// UnarchiveProject returns the todoist_unarchive_project tool
+func (tp *ToolProvider) UnarchiveProject() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[UnarchiveProjectParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[ArchiveProjectResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_unarchive_project",
+		Description:  "Restore an archived project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleUnarchiveProject handles the todoist_unarchive_project tool request
+func (tp *ToolProvider) HandleUnarchiveProject(ctx context.Context, request *mcp.CallToolRequest, params UnarchiveProjectParams) (*mcp.CallToolResult, error) {
+	id := params.ID
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Unarchiving project")
+
+	// Call the Todoist API
+	project, err := tp.client.UnarchiveProject(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to unarchive project")
+		return newToolResultAPIError("Failed to unarchive project", err, "project", id), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Convert project to JSON
+	response := ArchiveProjectResponse{
+		Project: *project,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// DeleteProject returns the todoist_delete_project tool
+func (tp *ToolProvider) DeleteProject() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[DeleteProjectParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_delete_project",
+		Description:  "Delete a project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleDeleteProject handles the todoist_delete_project tool request
+func (tp *ToolProvider) HandleDeleteProject(ctx context.Context, request *mcp.CallToolRequest, params DeleteProjectParams) (*mcp.CallToolResult, error) {
+	id := params.ID
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Deleting project")
+
+	// Call the Todoist API
+	err := tp.client.DeleteProject(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to delete project")
+		return newToolResultAPIError("Failed to delete project", err, "project", id), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Return success response
+	return jsonResult(SuccessResponse{Success: true}), nil
 }
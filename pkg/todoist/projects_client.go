@@ -1,19 +1,31 @@
 package todoist
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 )
 
-// GetProjects retrieves all projects
+// GetProjects retrieves all projects, following next_cursor across pages
 func (c *Client) GetProjects(ctx context.Context) ([]Project, error) {
-	endpoint := "/projects"
+	return collect(c.AllProjects(ctx))
+}
+
+// AllProjects returns an iterator over all projects that fetches pages lazily
+func (c *Client) AllProjects(ctx context.Context) iter.Seq2[Project, error] {
+	return paginate[Project](ctx, c, "/projects", nil, "get projects")
+}
+
+// GetProject retrieves a specific project by ID
+func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
+	endpoint := fmt.Sprintf("/projects/%s", id)
 
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get projects: %w", err)
+		return nil, fmt.Errorf("failed to get project: %w", err)
 	}
 
 	bodyBytes, err := c.processResponse(resp, http.StatusOK)
@@ -21,22 +33,57 @@ func (c *Client) GetProjects(ctx context.Context) ([]Project, error) {
 		return nil, err
 	}
 
-	// Parse paginated response
-	var paginatedResp PaginatedResponse[Project]
-	if err := json.Unmarshal(bodyBytes, &paginatedResp); err != nil {
+	// Parse response
+	var project Project
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return paginatedResp.Results, nil
+	return &project, nil
 }
 
-// GetProject retrieves a specific project by ID
-func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
+// CreateProject creates a new project, optionally nested under a parent project
+func (c *Client) CreateProject(ctx context.Context, req CreateProjectRequest) (*Project, error) {
+	endpoint := "/projects"
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create project: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var project Project
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// UpdateProject updates an existing project
+func (c *Client) UpdateProject(ctx context.Context, id string, req UpdateProjectRequest) (*Project, error) {
 	endpoint := fmt.Sprintf("/projects/%s", id)
 
-	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get project: %w", err)
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update project: %w", err)
 	}
 
 	bodyBytes, err := c.processResponse(resp, http.StatusOK)
@@ -52,3 +99,62 @@ func (c *Client) GetProject(ctx context.Context, id string) (*Project, error) {
 
 	return &project, nil
 }
+
+// ArchiveProject archives a project and its descendants
+func (c *Client) ArchiveProject(ctx context.Context, id string) (*Project, error) {
+	endpoint := fmt.Sprintf("/projects/%s/archive", id)
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to archive project: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var project Project
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// UnarchiveProject restores an archived project
+func (c *Client) UnarchiveProject(ctx context.Context, id string) (*Project, error) {
+	endpoint := fmt.Sprintf("/projects/%s/unarchive", id)
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to unarchive project: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var project Project
+	if err := json.Unmarshal(bodyBytes, &project); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &project, nil
+}
+
+// DeleteProject deletes a project and all of its tasks and sub-projects
+func (c *Client) DeleteProject(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/projects/%s", id)
+
+	resp, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete project: %w", err)
+	}
+
+	_, err = c.processResponse(resp, http.StatusNoContent)
+	return err
+}
@@ -1,7 +1,9 @@
 package todoist
 
 import (
+	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -32,7 +34,10 @@ func TestGetProjectsTool(t *testing.T) {
 	// Check properties
 	properties, ok := schema["properties"].(map[string]interface{})
 	assert.True(t, ok)
-	assert.Empty(t, properties) // No parameters required
+	assert.Len(t, properties, 2) // Only the optional format parameters
+	assert.Contains(t, properties, "format")
+	assert.Contains(t, properties, "fields")
+	assert.Nil(t, schema["required"])
 }
 
 func TestHandleGetProjects(t *testing.T) {
@@ -81,3 +86,117 @@ func TestHandleGetProject(t *testing.T) {
 	// テストが失敗します。代わりに、個々のツールのハンドラーをテストします。
 	t.Skip("Skipping TestHandleGetProject due to implementation issues")
 }
+
+func TestCreateProjectTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.CreateProject()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_create_project", tool.Name)
+	assert.Nil(t, tool.Annotations)
+
+	// Check input schema
+	var schema map[string]interface{}
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+	err = json.Unmarshal(schemaBytes, &schema)
+	assert.NoError(t, err)
+
+	required, ok := schema["required"].([]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, required, "name")
+
+	properties, ok := schema["properties"].(map[string]interface{})
+	assert.True(t, ok)
+	for _, name := range []string{"parentId", "color", "isFavorite", "viewStyle"} {
+		assert.Contains(t, properties, name)
+	}
+}
+
+func TestHandleCreateProject(t *testing.T) {
+	var body CreateProjectRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockProject()), nil
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleCreateProject, MockCallToolRequest(map[string]interface{}{
+		"name":       "Clients",
+		"parentId":   "987654321",
+		"color":      "blue",
+		"isFavorite": true,
+		"viewStyle":  "board",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, CreateProjectRequest{
+		Name:       "Clients",
+		ParentID:   "987654321",
+		Color:      "blue",
+		IsFavorite: true,
+		ViewStyle:  "board",
+	}, body)
+
+	var response CreateProjectResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Equal(t, "987654321", response.Project.ID)
+}
+
+func TestHandleUpdateProject(t *testing.T) {
+	var body map[string]interface{}
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockProject()), nil
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleUpdateProject, MockCallToolRequest(map[string]interface{}{
+		"id":         "987654321",
+		"name":       "Renamed",
+		"isFavorite": false,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, map[string]interface{}{"name": "Renamed", "is_favorite": false}, body)
+}
+
+func TestHandleArchiveProject(t *testing.T) {
+	archived := *MockProject()
+	archived.IsArchived = true
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		if req.URL.Path == "/api/v1/projects/987654321/archive" {
+			return MockResponse(200, archived), nil
+		}
+		return MockResponse(200, MockProject()), nil
+	})
+
+	params := map[string]interface{}{"id": "987654321"}
+
+	result, err := CallTypedTool(context.Background(), tp.HandleArchiveProject, MockCallToolRequest(params))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	var response ArchiveProjectResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.True(t, response.Project.IsArchived)
+
+	result, err = CallTypedTool(context.Background(), tp.HandleUnarchiveProject, MockCallToolRequest(params))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.False(t, response.Project.IsArchived)
+}
+
+func TestHandleDeleteProject(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		return MockResponse(204, nil), nil
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleDeleteProject, MockCallToolRequest(map[string]interface{}{
+		"id": "987654321",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+}
@@ -0,0 +1,100 @@
+package todoist
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// rateLimiter is a token bucket shared by all requests of a Client.
+// Requests that find the bucket empty are queued until a token becomes available.
+type rateLimiter struct {
+	mu      sync.Mutex
+	rate    float64 // tokens added per second
+	burst   float64 // bucket capacity
+	tokens  float64
+	last    time.Time
+	waiting int
+}
+
+// newRateLimiter returns a full token bucket that refills at ratePerSecond up to burst tokens
+func newRateLimiter(ratePerSecond float64, burst int) *rateLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &rateLimiter{
+		rate:   ratePerSecond,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		last:   time.Now(),
+	}
+}
+
+// WithRateLimit limits the client to ratePerSecond requests per second on average,
+// allowing bursts of up to burst requests. Requests over the limit wait for their turn
+// instead of failing. A rate of zero or less disables the limit.
+func WithRateLimit(ratePerSecond float64, burst int) ClientOption {
+	return func(c *Client) {
+		if ratePerSecond <= 0 {
+			c.limiter = nil
+			return
+		}
+		c.limiter = newRateLimiter(ratePerSecond, burst)
+	}
+}
+
+// reserve takes a token and returns how long the caller must wait before using it,
+// together with the number of requests queued including this one
+func (l *rateLimiter) reserve() (time.Duration, int) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	l.tokens += now.Sub(l.last).Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+	l.last = now
+
+	l.tokens--
+	if l.tokens >= 0 {
+		return 0, 0
+	}
+
+	l.waiting++
+	return time.Duration(-l.tokens / l.rate * float64(time.Second)), l.waiting
+}
+
+// done marks a queued request as no longer waiting. If the request gave up,
+// its token is returned to the bucket for the requests behind it.
+func (l *rateLimiter) done(cancelled bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.waiting--
+	if cancelled {
+		l.tokens++
+	}
+}
+
+// waitForRateLimit blocks until a request may be sent under the client's rate limit,
+// or the context is done
+func (c *Client) waitForRateLimit(ctx context.Context) error {
+	if c.limiter == nil {
+		return nil
+	}
+
+	delay, queued := c.limiter.reserve()
+	if delay <= 0 {
+		return nil
+	}
+
+	c.logger.WithFields(map[string]interface{}{
+		"queueDepth": queued,
+		"delay":      delay,
+	}).Info("Rate limit reached, queueing request")
+
+	err := sleepContext(ctx, delay)
+	c.limiter.done(err != nil)
+	return err
+}
@@ -0,0 +1,85 @@
+package todoist
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRateLimiterReserve(t *testing.T) {
+	limiter := newRateLimiter(10, 2)
+
+	// The burst is available immediately
+	for range 2 {
+		delay, queued := limiter.reserve()
+		assert.Zero(t, delay)
+		assert.Zero(t, queued)
+	}
+
+	// Further requests queue up behind each other
+	delay, queued := limiter.reserve()
+	assert.InDelta(t, 100*time.Millisecond, delay, float64(5*time.Millisecond))
+	assert.Equal(t, 1, queued)
+
+	delay, queued = limiter.reserve()
+	assert.InDelta(t, 200*time.Millisecond, delay, float64(5*time.Millisecond))
+	assert.Equal(t, 2, queued)
+
+	// A cancelled request gives its token back
+	limiter.done(true)
+	limiter.done(false)
+	delay, queued = limiter.reserve()
+	assert.InDelta(t, 200*time.Millisecond, delay, float64(5*time.Millisecond))
+	assert.Equal(t, 1, queued)
+}
+
+func TestRateLimitConcurrentRequests(t *testing.T) {
+	var calls atomic.Int32
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		calls.Add(1)
+		return MockResponse(200, MockTask()), nil
+	})
+	WithRateLimit(100, 5)(client)
+
+	// 10 requests with a burst of 5 at 100/s take at least ~50ms
+	start := time.Now()
+	var wg sync.WaitGroup
+	for range 10 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			_, err := client.GetTask(context.Background(), "123456789")
+			assert.NoError(t, err)
+		}()
+	}
+	wg.Wait()
+
+	assert.Equal(t, int32(10), calls.Load())
+	assert.GreaterOrEqual(t, time.Since(start), 40*time.Millisecond)
+}
+
+func TestRateLimitContextCancellation(t *testing.T) {
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, MockTask()), nil
+	})
+	WithRateLimit(0.001, 1)(client)
+
+	_, err := client.GetTask(context.Background(), "123456789")
+	assert.NoError(t, err)
+
+	// The next request would wait for ~17 minutes
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	_, err = client.GetTask(ctx, "123456789")
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+func TestWithRateLimitDisabled(t *testing.T) {
+	client := NewClient("test-token", WithRateLimit(0, 10))
+	assert.Nil(t, client.limiter)
+}
@@ -0,0 +1,341 @@
+package todoist
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// Response formats of the read tools
+const (
+	formatFull     = "full"
+	formatCompact  = "compact"
+	formatMarkdown = "markdown"
+)
+
+// responseFormat is the format in which a read tool renders its response
+type responseFormat struct {
+	name string
+	// fields are the fields kept in the compact format
+	fields []string
+}
+
+// objectRenderer renders one kind of Todoist object in the compact and markdown formats
+type objectRenderer[T any] struct {
+	// defaultFields are the fields kept in the compact format unless others are requested
+	defaultFields []string
+	// markdown renders an object as the text of a markdown list item
+	markdown func(T) string
+}
+
+var (
+	taskRenderer = objectRenderer[TaskView]{
+		defaultFields: []string{"id", "content", "project_id", "section_id", "parent_id", "priority", "due", "labels"},
+		markdown:      taskMarkdown,
+	}
+	projectRenderer = objectRenderer[Project]{
+		defaultFields: []string{"id", "name", "parent_id", "is_favorite", "inbox_project"},
+		markdown:      projectMarkdown,
+	}
+	sectionRenderer = objectRenderer[Section]{
+		defaultFields: []string{"id", "project_id", "name", "section_order"},
+		markdown:      sectionMarkdown,
+	}
+	labelRenderer = objectRenderer[Label]{
+		defaultFields: []string{"id", "name", "is_favorite"},
+		markdown:      labelMarkdown,
+	}
+	commentRenderer = objectRenderer[Comment]{
+		defaultFields: []string{"id", "item_id", "project_id", "content", "posted_at"},
+		markdown:      commentMarkdown,
+	}
+)
+
+// addFormatProperties adds the format and fields parameters to the input schema of a read tool
+func addFormatProperties[T any](inputSchema map[string]interface{}, renderer objectRenderer[T]) {
+	properties, _ := inputSchema["properties"].(map[string]interface{})
+	if properties == nil {
+		properties = map[string]interface{}{}
+		inputSchema["properties"] = properties
+	}
+
+	properties["format"] = map[string]interface{}{
+		"type":        "string",
+		"description": "Response format: 'full' (default) returns complete JSON objects; 'compact' returns JSON with only the fields listed in fields; 'markdown' returns a human-readable list. Use compact or markdown to save context on large results.",
+		"enum":        []string{formatFull, formatCompact, formatMarkdown},
+	}
+	properties["fields"] = map[string]interface{}{
+		"type":        "array",
+		"description": fmt.Sprintf("Fields to keep in the compact format, by their JSON name. Defaults to %s.", strings.Join(renderer.defaultFields, ", ")),
+		"items":       map[string]interface{}{"type": "string"},
+	}
+}
+
+// parseFormat parses the format and fields parameters of a read tool.
+// The fields are checked against the JSON fields of the rendered object.
+func parseFormat[T any](r *mcp.CallToolRequest, renderer objectRenderer[T]) (responseFormat, error) {
+	name, err := OptionalParam[string](r, "format")
+	if err != nil {
+		return responseFormat{}, err
+	}
+	fields, err := OptionalStringArrayParam(r, "fields")
+	if err != nil {
+		return responseFormat{}, err
+	}
+
+	switch name {
+	case "":
+		name = formatFull
+	case formatFull, formatCompact, formatMarkdown:
+	default:
+		return responseFormat{}, fmt.Errorf("format must be one of %s, %s or %s", formatFull, formatCompact, formatMarkdown)
+	}
+
+	if len(fields) > 0 && name != formatCompact {
+		return responseFormat{}, fmt.Errorf("fields can only be used with the %s format", formatCompact)
+	}
+	if len(fields) == 0 {
+		fields = renderer.defaultFields
+	}
+
+	known := jsonFieldNames[T]()
+	for _, field := range fields {
+		if !known[field] {
+			names := make([]string, 0, len(known))
+			for name := range known {
+				names = append(names, name)
+			}
+			sort.Strings(names)
+			return responseFormat{}, fmt.Errorf("unknown field %q; available fields are %s", field, strings.Join(names, ", "))
+		}
+	}
+
+	return responseFormat{name: name, fields: fields}, nil
+}
+
+// jsonFieldNames returns the names of the JSON fields of T
+func jsonFieldNames[T any]() map[string]bool {
+	var zero T
+	data, err := json.Marshal(zero)
+	if err != nil {
+		return nil
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil
+	}
+
+	names := make(map[string]bool, len(fields))
+	for name := range fields {
+		names[name] = true
+	}
+	return names
+}
+
+// pickFields returns the given JSON fields of an object
+func pickFields(object interface{}, fields []string) (map[string]json.RawMessage, error) {
+	data, err := json.Marshal(object)
+	if err != nil {
+		return nil, err
+	}
+	var all map[string]json.RawMessage
+	if err := json.Unmarshal(data, &all); err != nil {
+		return nil, err
+	}
+
+	picked := make(map[string]json.RawMessage, len(fields))
+	for _, field := range fields {
+		if value, ok := all[field]; ok {
+			picked[field] = value
+		}
+	}
+	return picked, nil
+}
+
+// renderList renders a read tool response that lists objects under key.
+// The full format returns the full response. The compact format reduces the objects to the
+// selected fields; extra holds any other fields of the response, such as a cursor.
+// The markdown format comes with the compact response as structured content.
+func renderList[T any](format responseFormat, renderer objectRenderer[T], full interface{}, key string, objects []T, extra map[string]interface{}) *mcp.CallToolResult {
+	if format.name == formatFull {
+		return jsonResult(full)
+	}
+
+	compact := make([]map[string]json.RawMessage, len(objects))
+	for i, object := range objects {
+		picked, err := pickFields(object, format.fields)
+		if err != nil {
+			return newToolResultError("Failed to marshal response", err)
+		}
+		compact[i] = picked
+	}
+	response := map[string]interface{}{key: compact}
+	for name, value := range extra {
+		response[name] = value
+	}
+
+	if format.name == formatMarkdown {
+		var b strings.Builder
+		if len(objects) == 0 {
+			fmt.Fprintf(&b, "No %s.\n", key)
+		}
+		for _, object := range objects {
+			b.WriteString("- " + renderer.markdown(object) + "\n")
+		}
+		writeMarkdownExtra(&b, extra)
+		return markdownResult(b.String(), response)
+	}
+	return jsonResult(response)
+}
+
+// renderObject renders a read tool response that holds a single object under key
+func renderObject[T any](format responseFormat, renderer objectRenderer[T], full interface{}, key string, object T) *mcp.CallToolResult {
+	if format.name == formatFull {
+		return jsonResult(full)
+	}
+
+	picked, err := pickFields(object, format.fields)
+	if err != nil {
+		return newToolResultError("Failed to marshal response", err)
+	}
+	response := map[string]interface{}{key: picked}
+
+	if format.name == formatMarkdown {
+		return markdownResult(renderer.markdown(object)+"\n", response)
+	}
+	return jsonResult(response)
+}
+
+// writeMarkdownExtra appends the extra fields of a response as lines of the form "name: value"
+func writeMarkdownExtra(b *strings.Builder, extra map[string]interface{}) {
+	names := make([]string, 0, len(extra))
+	for name := range extra {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	if len(names) > 0 {
+		b.WriteString("\n")
+	}
+	for _, name := range names {
+		fmt.Fprintf(b, "%s: %v\n", name, extra[name])
+	}
+}
+
+// jsonResult returns a response as structured content, with its JSON as the text fallback
+func jsonResult(response interface{}) *mcp.CallToolResult {
+	responseJSON, err := json.Marshal(response)
+	if err != nil {
+		return newToolResultError("Failed to marshal response", err)
+	}
+	result := newToolResultText(string(responseJSON))
+	result.StructuredContent = response
+	return result
+}
+
+// markdownResult returns markdown text together with a response as structured content
+func markdownResult(text string, response interface{}) *mcp.CallToolResult {
+	result := newToolResultText(text)
+	result.StructuredContent = response
+	return result
+}
+
+// appPriority returns the priority of a task as shown in the Todoist app, where p1 is the
+// highest. The API counts the other way round, with 4 for p1.
+func appPriority(priority int) string {
+	return fmt.Sprintf("p%d", maxPriority+1-priority)
+}
+
+// TaskView is a task as returned by the task tools, with its priority also given as shown
+// in the Todoist app, since the API counts priorities the other way round (priority 4 is p1)
+type TaskView struct {
+	Task
+	PriorityLabel string `json:"priority_label"` // p1 (urgent) to p4
+}
+
+// newTaskView returns the view of a task
+func newTaskView(task Task) TaskView {
+	view := TaskView{Task: task}
+	if task.Priority >= minPriority && task.Priority <= maxPriority {
+		view.PriorityLabel = appPriority(task.Priority)
+	}
+	return view
+}
+
+// newTaskViews returns the views of tasks
+func newTaskViews(tasks []Task) []TaskView {
+	views := make([]TaskView, len(tasks))
+	for i, task := range tasks {
+		views[i] = newTaskView(task)
+	}
+	return views
+}
+
+// taskMarkdown renders a task as a checklist item with its due date, priority and labels
+func taskMarkdown(task TaskView) string {
+	box := "[ ]"
+	if task.Checked {
+		box = "[x]"
+	}
+
+	var details []string
+	if task.Due != nil {
+		due := task.Due.Date
+		if task.Due.Datetime != "" {
+			due = task.Due.Datetime
+		}
+		if task.Due.IsRecurring && task.Due.String != "" {
+			due += " (" + task.Due.String + ")"
+		}
+		details = append(details, "due "+due)
+	}
+	if task.Deadline != nil {
+		details = append(details, "deadline "+task.Deadline.Date)
+	}
+	if task.Priority > 1 {
+		details = append(details, task.PriorityLabel)
+	}
+	for _, label := range task.Labels {
+		details = append(details, "@"+label)
+	}
+	details = append(details, "id "+task.ID)
+
+	return fmt.Sprintf("%s %s (%s)", box, task.Content, strings.Join(details, ", "))
+}
+
+// projectMarkdown renders a project with its ID
+func projectMarkdown(project Project) string {
+	details := []string{"id " + project.ID}
+	if project.InboxProject {
+		details = append(details, "inbox")
+	}
+	if project.IsFavorite {
+		details = append(details, "favorite")
+	}
+	if project.ParentID != nil {
+		details = append(details, "parent "+*project.ParentID)
+	}
+	return fmt.Sprintf("%s (%s)", project.Name, strings.Join(details, ", "))
+}
+
+// sectionMarkdown renders a section with its ID and project
+func sectionMarkdown(section Section) string {
+	return fmt.Sprintf("%s (id %s, project %s)", section.Name, section.ID, section.ProjectID)
+}
+
+// labelMarkdown renders a label with its ID
+func labelMarkdown(label Label) string {
+	return fmt.Sprintf("@%s (id %s)", label.Name, label.ID)
+}
+
+// commentMarkdown renders a comment with its posting time
+func commentMarkdown(comment Comment) string {
+	posted := ""
+	if comment.PostedAt != nil {
+		posted = *comment.PostedAt + ", "
+	}
+	return fmt.Sprintf("%s (%sid %s)", comment.Content, posted, comment.ID)
+}
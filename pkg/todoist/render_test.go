@@ -0,0 +1,179 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseFormat(t *testing.T) {
+	tests := []struct {
+		name       string
+		params     map[string]interface{}
+		wantFormat responseFormat
+		wantErr    string
+	}{
+		{
+			name:       "default",
+			params:     map[string]interface{}{},
+			wantFormat: responseFormat{name: formatFull, fields: labelRenderer.defaultFields},
+		},
+		{
+			name:       "compact with default fields",
+			params:     map[string]interface{}{"format": "compact"},
+			wantFormat: responseFormat{name: formatCompact, fields: labelRenderer.defaultFields},
+		},
+		{
+			name:       "compact with fields",
+			params:     map[string]interface{}{"format": "compact", "fields": []interface{}{"name", "color"}},
+			wantFormat: responseFormat{name: formatCompact, fields: []string{"name", "color"}},
+		},
+		{
+			name:    "unknown format",
+			params:  map[string]interface{}{"format": "yaml"},
+			wantErr: "format must be one of",
+		},
+		{
+			name:    "fields without compact",
+			params:  map[string]interface{}{"format": "markdown", "fields": []interface{}{"name"}},
+			wantErr: "only be used with the compact format",
+		},
+		{
+			name:    "unknown field",
+			params:  map[string]interface{}{"format": "compact", "fields": []interface{}{"title"}},
+			wantErr: `unknown field "title"; available fields are color, id, is_favorite, name, order`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			format, err := parseFormat(MockCallToolRequest(tt.params), labelRenderer)
+			if tt.wantErr != "" {
+				assert.ErrorContains(t, err, tt.wantErr)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantFormat, format)
+		})
+	}
+}
+
+func TestRenderList(t *testing.T) {
+	tasks := newTaskViews([]Task{
+		{ID: "1", Content: "Buy milk", ProjectID: "p1", Priority: 4, Labels: []string{"errand"}, Due: &Due{Date: "2025-01-15"}},
+		{ID: "2", Content: "Water plants", ProjectID: "p1", Priority: 1, Checked: true, Due: &Due{Date: "2025-01-16", IsRecurring: true, String: "every day"}},
+	})
+	full := GetCompletedTasksResponse{Tasks: tasks, NextCursor: "abc"}
+	extra := map[string]interface{}{"nextCursor": "abc"}
+
+	t.Run("full", func(t *testing.T) {
+		result := renderList(responseFormat{name: formatFull}, taskRenderer, full, "tasks", tasks, extra)
+		var response GetCompletedTasksResponse
+		require.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+
+		assert.Equal(t, full, response)
+
+		// Priorities are given on both scales
+		assert.Equal(t, 4, response.Tasks[0].Priority)
+		assert.Equal(t, "p1", response.Tasks[0].PriorityLabel)
+		assert.Equal(t, "p4", response.Tasks[1].PriorityLabel)
+	})
+
+	t.Run("compact", func(t *testing.T) {
+		result := renderList(responseFormat{name: formatCompact, fields: []string{"id", "content"}}, taskRenderer, full, "tasks", tasks, extra)
+		assert.JSONEq(t, `{"tasks":[{"id":"1","content":"Buy milk"},{"id":"2","content":"Water plants"}],"nextCursor":"abc"}`, resultText(result))
+	})
+
+	t.Run("markdown", func(t *testing.T) {
+		result := renderList(responseFormat{name: formatMarkdown, fields: []string{"id", "content"}}, taskRenderer, full, "tasks", tasks, extra)
+		assert.Equal(t, "- [ ] Buy milk (due 2025-01-15, p1, @errand, id 1)\n"+
+			"- [x] Water plants (due 2025-01-16 (every day), id 2)\n"+
+			"\nnextCursor: abc\n", resultText(result))
+
+		// The structured content is the compact response, not the full one
+		structured, err := json.Marshal(result.StructuredContent)
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"tasks":[{"id":"1","content":"Buy milk"},{"id":"2","content":"Water plants"}],"nextCursor":"abc"}`, string(structured))
+	})
+
+	t.Run("markdown without items", func(t *testing.T) {
+		result := renderList(responseFormat{name: formatMarkdown}, labelRenderer, GetLabelsResponse{}, "labels", []Label{}, nil)
+		assert.Equal(t, "No labels.\n", resultText(result))
+	})
+}
+
+func TestRenderObject(t *testing.T) {
+	project := Project{ID: "p1", Name: "Inbox", InboxProject: true, Color: "grey"}
+	full := GetProjectResponse{Project: project}
+
+	result := renderObject(responseFormat{name: formatCompact, fields: []string{"id", "name"}}, projectRenderer, full, "project", project)
+	assert.JSONEq(t, `{"project":{"id":"p1","name":"Inbox"}}`, resultText(result))
+
+	result = renderObject(responseFormat{name: formatMarkdown, fields: []string{"id", "name"}}, projectRenderer, full, "project", project)
+	assert.Equal(t, "Inbox (id p1, inbox)\n", resultText(result))
+	structured, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"project":{"id":"p1","name":"Inbox"}}`, string(structured))
+
+	result = renderObject(responseFormat{name: formatFull}, projectRenderer, full, "project", project)
+	assert.Contains(t, resultText(result), `"color":"grey"`)
+}
+
+func TestRenderTaskTree(t *testing.T) {
+	trees := []ProjectTaskTree{{
+		ID:   "p1",
+		Name: "Work",
+		Tasks: []TaskNode{{
+			Task:     newTaskView(Task{ID: "1", Content: "Plan offsite"}),
+			Subtasks: []TaskNode{{Task: newTaskView(Task{ID: "2", Content: "Book venue", Priority: 3})}},
+		}},
+		Sections: []SectionTaskTree{{ID: "s1", Name: "Next", Tasks: []TaskNode{{Task: newTaskView(Task{ID: "3", Content: "Send agenda"})}}}},
+	}}
+
+	result := renderTaskTree(responseFormat{name: formatMarkdown, fields: []string{"id"}}, trees)
+	assert.Equal(t, "## Work\n"+
+		"- [ ] Plan offsite (id 1)\n"+
+		"  - [ ] Book venue (p2, id 2)\n"+
+		"\n### Next\n"+
+		"- [ ] Send agenda (id 3)\n", resultText(result))
+	structured, err := json.Marshal(result.StructuredContent)
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"projects":[{"id":"p1","name":"Work",
+		"tasks":[{"task":{"id":"1"},"subtasks":[{"task":{"id":"2"}}]}],
+		"sections":[{"id":"s1","name":"Next","tasks":[{"task":{"id":"3"}}]}]}]}`, string(structured))
+
+	result = renderTaskTree(responseFormat{name: formatCompact, fields: []string{"content"}}, trees)
+	assert.JSONEq(t, `{"projects":[{"id":"p1","name":"Work",
+		"tasks":[{"task":{"content":"Plan offsite"},"subtasks":[{"task":{"content":"Book venue"}}]}],
+		"sections":[{"id":"s1","name":"Next","tasks":[{"task":{"content":"Send agenda"}}]}]}]}`, resultText(result))
+}
+
+func TestHandleGetTasksFormats(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, MockPaginatedTasks([]Task{*MockTask()})), nil
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{
+		"format": "compact",
+		"fields": []interface{}{"id", "due"},
+	}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	var response map[string][]map[string]interface{}
+	require.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	require.Len(t, response["tasks"], 1)
+	assert.Len(t, response["tasks"][0], 2)
+	assert.Equal(t, "123456789", response["tasks"][0]["id"])
+
+	result, err = CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{
+		"format": "compact",
+		"fields": []interface{}{"user_name"},
+	}))
+	require.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), `unknown field "user_name"`)
+}
@@ -0,0 +1,258 @@
+package todoist
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// nameCacheTTL is how long project and section lists are reused for name lookups
+const nameCacheTTL = 5 * time.Minute
+
+// nameCache caches the project and section lists used to resolve names to IDs.
+// The zero value is ready to use.
+type nameCache struct {
+	mu                sync.Mutex
+	projects          []Project
+	sections          []Section
+	projectsFetchedAt time.Time
+	sectionsFetchedAt time.Time
+}
+
+// invalidate drops the cached lists, so the next lookup fetches them again
+func (nc *nameCache) invalidate() {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	nc.projects = nil
+	nc.sections = nil
+}
+
+// getProjects returns the cached projects, fetching them when missing, expired or refresh is set
+func (nc *nameCache) getProjects(ctx context.Context, client TodoistClient, refresh bool) ([]Project, error) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if refresh || nc.projects == nil || time.Since(nc.projectsFetchedAt) > nameCacheTTL {
+		projects, err := client.GetProjects(ctx)
+		if err != nil {
+			return nil, err
+		}
+		nc.projects = projects
+		nc.projectsFetchedAt = time.Now()
+	}
+	return nc.projects, nil
+}
+
+// getSections returns the cached sections of all projects, fetching them when missing,
+// expired or refresh is set
+func (nc *nameCache) getSections(ctx context.Context, client TodoistClient, refresh bool) ([]Section, error) {
+	nc.mu.Lock()
+	defer nc.mu.Unlock()
+
+	if refresh || nc.sections == nil || time.Since(nc.sectionsFetchedAt) > nameCacheTTL {
+		sections, err := client.GetSections(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		nc.sections = sections
+		nc.sectionsFetchedAt = time.Now()
+	}
+	return nc.sections, nil
+}
+
+// projectPaths returns the slash-separated path of every project, e.g. "Work/Clients", keyed by project ID
+func projectPaths(projects []Project) map[string]string {
+	byID := make(map[string]Project, len(projects))
+	for _, project := range projects {
+		byID[project.ID] = project
+	}
+
+	paths := make(map[string]string, len(projects))
+	for _, project := range projects {
+		names := []string{project.Name}
+		seen := map[string]bool{project.ID: true}
+		for parentID := project.ParentID; parentID != nil; {
+			parent, ok := byID[*parentID]
+			if !ok || seen[parent.ID] {
+				break
+			}
+			seen[parent.ID] = true
+			names = append([]string{parent.Name}, names...)
+			parentID = parent.ParentID
+		}
+		paths[project.ID] = strings.Join(names, "/")
+	}
+	return paths
+}
+
+// matchesName reports whether name refers to an item with the given path.
+// Names are compared case-insensitively; a name without a slash also matches the last path segment.
+func matchesName(name, path string) bool {
+	name = strings.Trim(strings.TrimSpace(name), "/")
+	if strings.EqualFold(name, path) {
+		return true
+	}
+	if strings.Contains(name, "/") {
+		return false
+	}
+	return strings.EqualFold(name, path[strings.LastIndex(path, "/")+1:])
+}
+
+// ambiguousNameError is returned when a name matches more than one project or section
+type ambiguousNameError struct {
+	kind       string
+	name       string
+	candidates []string
+}
+
+// Error lists the candidates matching the name
+func (e *ambiguousNameError) Error() string {
+	return fmt.Sprintf("%s name %q is ambiguous; matching %ss: %s. Use a full path or the %s ID instead",
+		e.kind, e.name, e.kind, strings.Join(e.candidates, ", "), e.kind)
+}
+
+// isAmbiguousName reports whether err is an ambiguousNameError
+func isAmbiguousName(err error) bool {
+	var ambiguous *ambiguousNameError
+	return errors.As(err, &ambiguous)
+}
+
+// findProjectID looks up a project ID by name or path in the given projects
+func findProjectID(projects []Project, name string) (string, error) {
+	paths := projectPaths(projects)
+
+	var matches []string
+	for _, project := range projects {
+		if matchesName(name, paths[project.ID]) {
+			matches = append(matches, project.ID)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		return "", fmt.Errorf("no project named %q", name)
+	case 1:
+		return matches[0], nil
+	}
+
+	candidates := make([]string, len(matches))
+	for i, id := range matches {
+		candidates[i] = fmt.Sprintf("%s (id %s)", paths[id], id)
+	}
+	sort.Strings(candidates)
+	return "", &ambiguousNameError{kind: "project", name: name, candidates: candidates}
+}
+
+// findSection looks up a section by name in the given sections, restricted to
+// projectID when it is not empty
+func findSection(sections []Section, paths map[string]string, projectID, name string) (*Section, error) {
+	var matches []Section
+	for _, section := range sections {
+		if projectID != "" && section.ProjectID != projectID {
+			continue
+		}
+		if strings.EqualFold(strings.TrimSpace(name), section.Name) {
+			matches = append(matches, section)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		if projectID != "" {
+			return nil, fmt.Errorf("no section named %q in project %s", name, projectID)
+		}
+		return nil, fmt.Errorf("no section named %q", name)
+	case 1:
+		return &matches[0], nil
+	}
+
+	candidates := make([]string, len(matches))
+	for i, section := range matches {
+		candidates[i] = fmt.Sprintf("%s/%s (id %s)", paths[section.ProjectID], section.Name, section.ID)
+	}
+	sort.Strings(candidates)
+	return nil, &ambiguousNameError{kind: "section", name: name, candidates: candidates}
+}
+
+// resolveProjectID returns the ID of the project with the given name or path.
+// The cached project list is refreshed once when no project matches, in case it was just created.
+func (tp *ToolProvider) resolveProjectID(ctx context.Context, name string) (string, error) {
+	projects, err := tp.names.getProjects(ctx, tp.client, false)
+	if err != nil {
+		return "", fmt.Errorf("failed to get projects: %w", err)
+	}
+
+	id, err := findProjectID(projects, name)
+	if err == nil || isAmbiguousName(err) {
+		return id, err
+	}
+
+	projects, refreshErr := tp.names.getProjects(ctx, tp.client, true)
+	if refreshErr != nil {
+		return "", fmt.Errorf("failed to get projects: %w", refreshErr)
+	}
+	return findProjectID(projects, name)
+}
+
+// resolveSection returns the section with the given name, within projectID when it is not empty.
+// The cached section list is refreshed once when no section matches, in case it was just created.
+func (tp *ToolProvider) resolveSection(ctx context.Context, projectID, name string) (*Section, error) {
+	projects, err := tp.names.getProjects(ctx, tp.client, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get projects: %w", err)
+	}
+	paths := projectPaths(projects)
+
+	sections, err := tp.names.getSections(ctx, tp.client, false)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+
+	section, err := findSection(sections, paths, projectID, name)
+	if err == nil || isAmbiguousName(err) {
+		return section, err
+	}
+
+	sections, err = tp.names.getSections(ctx, tp.client, true)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get sections: %w", err)
+	}
+	return findSection(sections, paths, projectID, name)
+}
+
+// resolveLocation resolves the projectName and sectionName tool parameters to IDs.
+// An ID and a name for the same kind may not both be given.
+func (tp *ToolProvider) resolveLocation(ctx context.Context, projectID, projectName, sectionID, sectionName string) (string, string, error) {
+	if projectID != "" && projectName != "" {
+		return "", "", errors.New("only one of projectId or projectName may be specified")
+	}
+	if sectionID != "" && sectionName != "" {
+		return "", "", errors.New("only one of sectionId or sectionName may be specified")
+	}
+
+	if projectName != "" {
+		id, err := tp.resolveProjectID(ctx, projectName)
+		if err != nil {
+			return "", "", err
+		}
+		projectID = id
+	}
+
+	if sectionName != "" {
+		section, err := tp.resolveSection(ctx, projectID, sectionName)
+		if err != nil {
+			return "", "", err
+		}
+		sectionID = section.ID
+		if projectID == "" {
+			projectID = section.ProjectID
+		}
+	}
+
+	return projectID, sectionID, nil
+}
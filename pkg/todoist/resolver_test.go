@@ -0,0 +1,199 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// mockProjects returns a project tree with two "Clients" sub-projects
+func mockProjects() []Project {
+	return []Project{
+		{ID: "1", Name: "Work"},
+		{ID: "2", Name: "Clients", ParentID: strPtr("1")},
+		{ID: "3", Name: "Personal"},
+		{ID: "4", Name: "Clients", ParentID: strPtr("3")},
+		{ID: "5", Name: "Inbox"},
+	}
+}
+
+// mockSections returns sections of the projects in mockProjects
+func mockSections() []Section {
+	return []Section{
+		{ID: "s1", ProjectID: "1", Name: "Doing"},
+		{ID: "s2", ProjectID: "3", Name: "Doing"},
+		{ID: "s3", ProjectID: "3", Name: "Someday"},
+	}
+}
+
+// newResolverMockToolProvider serves mockProjects and mockSections and counts the list requests
+func newResolverMockToolProvider(t *testing.T, calls map[string]int, handle func(req *http.Request) *http.Response) *ToolProvider {
+	return NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		calls[req.Method+" "+req.URL.Path]++
+		switch req.Method + " " + req.URL.Path {
+		case "GET /api/v1/projects":
+			return MockResponse(200, PaginatedResponse[Project]{Results: mockProjects()}), nil
+		case "GET /api/v1/sections":
+			return MockResponse(200, PaginatedResponse[Section]{Results: mockSections()}), nil
+		}
+		if handle != nil {
+			return handle(req), nil
+		}
+		t.Fatalf("unexpected request %s %s", req.Method, req.URL.Path)
+		return nil, nil
+	})
+}
+
+func TestFindProjectID(t *testing.T) {
+	tests := []struct {
+		name          string
+		query         string
+		wantID        string
+		wantErr       string
+		wantAmbiguous bool
+	}{
+		{name: "top-level name", query: "Work", wantID: "1"},
+		{name: "case-insensitive", query: "inbox", wantID: "5"},
+		{name: "hierarchical path", query: "work/clients", wantID: "2"},
+		{name: "path with surrounding slashes", query: "/Personal/Clients/", wantID: "4"},
+		{
+			name:          "ambiguous name lists candidates",
+			query:         "Clients",
+			wantErr:       `project name "Clients" is ambiguous; matching projects: Personal/Clients (id 4), Work/Clients (id 2)`,
+			wantAmbiguous: true,
+		},
+		{name: "unknown name", query: "Errands", wantErr: `no project named "Errands"`},
+		{name: "path does not match leaf only", query: "Home/Clients", wantErr: `no project named "Home/Clients"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, err := findProjectID(mockProjects(), tt.query)
+			if tt.wantErr != "" {
+				assert.Error(t, err)
+				assert.Contains(t, err.Error(), tt.wantErr)
+				assert.Equal(t, tt.wantAmbiguous, isAmbiguousName(err))
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantID, id)
+		})
+	}
+}
+
+func TestResolveLocation(t *testing.T) {
+	tests := []struct {
+		name          string
+		projectID     string
+		projectName   string
+		sectionID     string
+		sectionName   string
+		wantProjectID string
+		wantSectionID string
+		wantErr       bool
+	}{
+		{name: "ids pass through", projectID: "1", sectionID: "s1", wantProjectID: "1", wantSectionID: "s1"},
+		{name: "project name", projectName: "Personal", wantProjectID: "3"},
+		{name: "section within project", projectName: "Personal", sectionName: "doing", wantProjectID: "3", wantSectionID: "s2"},
+		{name: "section across projects", sectionName: "Someday", wantProjectID: "3", wantSectionID: "s3"},
+		{name: "ambiguous section", sectionName: "Doing", wantErr: true},
+		{name: "project id and name", projectID: "1", projectName: "Work", wantErr: true},
+		{name: "section id and name", sectionID: "s1", sectionName: "Doing", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := newResolverMockToolProvider(t, map[string]int{}, nil)
+
+			projectID, sectionID, err := tp.resolveLocation(context.Background(), tt.projectID, tt.projectName, tt.sectionID, tt.sectionName)
+			if tt.wantErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantProjectID, projectID)
+			assert.Equal(t, tt.wantSectionID, sectionID)
+		})
+	}
+}
+
+func TestNameCache(t *testing.T) {
+	calls := map[string]int{}
+	tp := newResolverMockToolProvider(t, calls, nil)
+	ctx := context.Background()
+
+	// Lookups reuse the cached project list
+	for _, name := range []string{"Work", "Personal", "Work/Clients"} {
+		_, err := tp.resolveProjectID(ctx, name)
+		assert.NoError(t, err)
+	}
+	assert.Equal(t, 1, calls["GET /api/v1/projects"])
+
+	// A miss refreshes the list once before failing
+	_, err := tp.resolveProjectID(ctx, "Errands")
+	assert.Error(t, err)
+	assert.Equal(t, 2, calls["GET /api/v1/projects"])
+
+	// Invalidation forces a fetch on the next lookup
+	tp.names.invalidate()
+	_, err = tp.resolveProjectID(ctx, "Work")
+	assert.NoError(t, err)
+	assert.Equal(t, 3, calls["GET /api/v1/projects"])
+}
+
+func TestHandleGetTasksByName(t *testing.T) {
+	calls := map[string]int{}
+	tp := newResolverMockToolProvider(t, calls, func(req *http.Request) *http.Response {
+		assert.Equal(t, "/api/v1/tasks", req.URL.Path)
+		assert.Equal(t, "3", req.URL.Query().Get("project_id"))
+		return MockResponse(200, PaginatedResponse[Task]{Results: []Task{
+			{ID: "t1", ProjectID: "3", SectionID: strPtr("s2")},
+			{ID: "t2", ProjectID: "3", SectionID: strPtr("s3")},
+			{ID: "t3", ProjectID: "3"},
+		}})
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{
+		"projectName": "personal",
+		"sectionName": "Doing",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var response GetTasksResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Len(t, response.Tasks, 1)
+	assert.Equal(t, "t1", response.Tasks[0].ID)
+}
+
+func TestHandleGetTasksAmbiguousProject(t *testing.T) {
+	tp := newResolverMockToolProvider(t, map[string]int{}, nil)
+
+	result, err := CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{
+		"projectName": "Clients",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+	assert.Contains(t, resultText(result), "Work/Clients (id 2)")
+	assert.Contains(t, resultText(result), "Personal/Clients (id 4)")
+}
+
+func TestHandleCreateTaskByName(t *testing.T) {
+	var body map[string]interface{}
+	tp := newResolverMockToolProvider(t, map[string]int{}, func(req *http.Request) *http.Response {
+		assert.Equal(t, "POST /api/v1/tasks", req.Method+" "+req.URL.Path)
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockTask())
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(map[string]interface{}{
+		"content":     "Write proposal",
+		"projectName": "Work/Clients",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "2", body["project_id"])
+}
@@ -0,0 +1,147 @@
+package todoist
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+// idempotencyKeyHeader is the header Todoist uses to deduplicate retried write requests
+const idempotencyKeyHeader = "X-Request-Id"
+
+// RetryPolicy configures how requests that fail with a transient error are retried.
+// The zero value disables retries.
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, including the first one.
+	// Values of 1 or less disable retries.
+	MaxAttempts int
+	// InitialBackoff is the base delay before the first retry. It doubles on every further retry.
+	InitialBackoff time.Duration
+	// MaxBackoff caps the delay computed from InitialBackoff.
+	MaxBackoff time.Duration
+	// MaxRetryAfter is the longest Retry-After delay that is waited for.
+	// Responses asking for a longer delay are returned without retrying. Zero means no limit.
+	MaxRetryAfter time.Duration
+}
+
+// DefaultRetryPolicy retries rate limited and transient server errors up to three times
+var DefaultRetryPolicy = RetryPolicy{
+	MaxAttempts:    4,
+	InitialBackoff: 500 * time.Millisecond,
+	MaxBackoff:     10 * time.Second,
+	MaxRetryAfter:  time.Minute,
+}
+
+// WithRetryPolicy sets the retry policy for requests.
+// Only idempotent requests and requests carrying an idempotency key are retried.
+func WithRetryPolicy(policy RetryPolicy) ClientOption {
+	return func(c *Client) {
+		c.retryPolicy = policy
+	}
+}
+
+// retryableStatus reports whether a response status is worth retrying
+func retryableStatus(status int) bool {
+	switch status {
+	case http.StatusTooManyRequests, http.StatusInternalServerError, http.StatusBadGateway,
+		http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	}
+	return false
+}
+
+// retryableRequest reports whether a request can safely be sent again
+func retryableRequest(req *http.Request) bool {
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+	return req.Header.Get(idempotencyKeyHeader) != ""
+}
+
+// retryDelay returns how long to wait before retrying after the given attempt (starting at 1),
+// and false if the request should not be retried
+func (p RetryPolicy) retryDelay(req *http.Request, resp *http.Response, err error, attempt int) (time.Duration, bool) {
+	if attempt >= p.MaxAttempts || !retryableRequest(req) {
+		return 0, false
+	}
+
+	if err != nil {
+		// Cancellation and deadlines are final
+		if req.Context().Err() != nil || errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return 0, false
+		}
+		return p.backoff(attempt), true
+	}
+
+	if !retryableStatus(resp.StatusCode) {
+		return 0, false
+	}
+
+	if delay, ok := parseRetryAfter(resp.Header.Get("Retry-After"), time.Now()); ok {
+		if p.MaxRetryAfter > 0 && delay > p.MaxRetryAfter {
+			return 0, false
+		}
+		return delay, true
+	}
+
+	return p.backoff(attempt), true
+}
+
+// backoff returns the jittered exponential delay after the given attempt.
+// The delay is drawn uniformly from the upper half of the exponential value.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	delay := p.InitialBackoff
+	for i := 1; i < attempt && (p.MaxBackoff <= 0 || delay < p.MaxBackoff); i++ {
+		delay *= 2
+	}
+	if p.MaxBackoff > 0 && delay > p.MaxBackoff {
+		delay = p.MaxBackoff
+	}
+	if delay <= 0 {
+		return 0
+	}
+
+	half := delay / 2
+	return half + rand.N(delay-half+1)
+}
+
+// parseRetryAfter parses a Retry-After header given in seconds or as an HTTP date
+func parseRetryAfter(value string, now time.Time) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+
+	if seconds, err := strconv.Atoi(value); err == nil {
+		if seconds < 0 {
+			return 0, false
+		}
+		return time.Duration(seconds) * time.Second, true
+	}
+
+	if date, err := http.ParseTime(value); err == nil {
+		delay := date.Sub(now)
+		if delay < 0 {
+			delay = 0
+		}
+		return delay, true
+	}
+
+	return 0, false
+}
+
+// sleepContext waits for the given duration or until the context is done
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
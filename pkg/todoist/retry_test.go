@@ -0,0 +1,210 @@
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// fastRetryPolicy retries without noticeable delays
+var fastRetryPolicy = RetryPolicy{
+	MaxAttempts:    3,
+	InitialBackoff: time.Millisecond,
+	MaxBackoff:     2 * time.Millisecond,
+}
+
+func TestRetryPolicy(t *testing.T) {
+	tests := []struct {
+		name         string
+		method       string
+		header       http.Header
+		statuses     []int
+		transportErr error
+		policy       RetryPolicy
+		wantStatus   int
+		wantAttempts int
+	}{
+		{
+			name:         "retries transient errors until success",
+			method:       "GET",
+			statuses:     []int{503, 429, 200},
+			policy:       fastRetryPolicy,
+			wantStatus:   200,
+			wantAttempts: 3,
+		},
+		{
+			name:         "gives up after max attempts",
+			method:       "GET",
+			statuses:     []int{500, 502, 504, 200},
+			policy:       fastRetryPolicy,
+			wantStatus:   504,
+			wantAttempts: 3,
+		},
+		{
+			name:         "does not retry client errors",
+			method:       "GET",
+			statuses:     []int{404},
+			policy:       fastRetryPolicy,
+			wantStatus:   404,
+			wantAttempts: 1,
+		},
+		{
+			name:         "does not retry non-idempotent requests",
+			method:       "POST",
+			statuses:     []int{503, 200},
+			policy:       fastRetryPolicy,
+			wantStatus:   503,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retries requests with an idempotency key",
+			method:       "POST",
+			header:       http.Header{idempotencyKeyHeader: []string{"key"}},
+			statuses:     []int{503, 200},
+			policy:       fastRetryPolicy,
+			wantStatus:   200,
+			wantAttempts: 2,
+		},
+		{
+			name:         "retries transport errors",
+			method:       "DELETE",
+			statuses:     []int{0, 204},
+			transportErr: errors.New("connection reset"),
+			policy:       fastRetryPolicy,
+			wantStatus:   204,
+			wantAttempts: 2,
+		},
+		{
+			name:         "zero policy disables retries",
+			method:       "GET",
+			statuses:     []int{503, 200},
+			wantStatus:   503,
+			wantAttempts: 1,
+		},
+		{
+			name:         "retry-after beyond the limit is not waited for",
+			method:       "GET",
+			header:       http.Header{"Retry-After": []string{"120"}},
+			statuses:     []int{429, 200},
+			policy:       RetryPolicy{MaxAttempts: 3, MaxRetryAfter: time.Second},
+			wantStatus:   429,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			attempts := 0
+			client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+				status := tt.statuses[attempts]
+				attempts++
+				if status == 0 {
+					return nil, tt.transportErr
+				}
+				resp := MockResponse(status, nil)
+				if retryAfter := tt.header.Get("Retry-After"); retryAfter != "" {
+					resp.Header = http.Header{"Retry-After": []string{retryAfter}}
+				}
+				return resp, nil
+			})
+			WithRetryPolicy(tt.policy)(client)
+
+			// The idempotency key is set by the caller, so send the request directly
+			req, err := http.NewRequest(tt.method, client.baseURL+"/tasks", nil)
+			assert.NoError(t, err)
+			for key, values := range tt.header {
+				if key != "Retry-After" {
+					req.Header[key] = values
+				}
+			}
+			resp, err := client.retryDo(req)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantStatus, resp.StatusCode)
+			assert.Equal(t, tt.wantAttempts, attempts)
+		})
+	}
+}
+
+func TestRetryResendsBody(t *testing.T) {
+	var bodies []string
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		body, err := io.ReadAll(req.Body)
+		assert.NoError(t, err)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			return MockResponse(503, nil), nil
+		}
+		return MockResponse(204, nil), nil
+	})
+	WithRetryPolicy(fastRetryPolicy)(client)
+
+	resp, err := client.doRequest(context.Background(), "PUT", "/tasks/1", bytes.NewBufferString(`{"content":"x"}`))
+	assert.NoError(t, err)
+	assert.Equal(t, 204, resp.StatusCode)
+	assert.Equal(t, []string{`{"content":"x"}`, `{"content":"x"}`}, bodies)
+}
+
+func TestRetryRespectsContextCancellation(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	attempts := 0
+	client := NewMockClient(func(req *http.Request) (*http.Response, error) {
+		attempts++
+		cancel()
+		return MockResponse(503, nil), nil
+	})
+	WithRetryPolicy(RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Hour})(client)
+
+	_, err := client.doRequest(ctx, "GET", "/tasks", nil)
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 1, attempts)
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	now := time.Date(2025, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		value     string
+		wantDelay time.Duration
+		wantOK    bool
+	}{
+		{name: "seconds", value: "3", wantDelay: 3 * time.Second, wantOK: true},
+		{name: "http date", value: "Wed, 01 Jan 2025 12:00:10 GMT", wantDelay: 10 * time.Second, wantOK: true},
+		{name: "date in the past", value: "Wed, 01 Jan 2025 11:00:00 GMT", wantDelay: 0, wantOK: true},
+		{name: "empty", value: "", wantOK: false},
+		{name: "negative", value: "-1", wantOK: false},
+		{name: "invalid", value: "soon", wantOK: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			delay, ok := parseRetryAfter(tt.value, now)
+			assert.Equal(t, tt.wantOK, ok)
+			assert.Equal(t, tt.wantDelay, delay)
+		})
+	}
+}
+
+func TestRetryBackoff(t *testing.T) {
+	policy := RetryPolicy{InitialBackoff: 100 * time.Millisecond, MaxBackoff: time.Second}
+
+	for attempt, want := range map[int]time.Duration{
+		1: 100 * time.Millisecond,
+		2: 200 * time.Millisecond,
+		3: 400 * time.Millisecond,
+		5: time.Second,
+	} {
+		for range 10 {
+			delay := policy.backoff(attempt)
+			assert.GreaterOrEqual(t, delay, want/2)
+			assert.LessOrEqual(t, delay, want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package todoist
+
+import (
+	"encoding/json"
+	"fmt"
+	"maps"
+	"reflect"
+	"strings"
+
+	"github.com/google/jsonschema-go/jsonschema"
+)
+
+// SuccessResponse represents the response from tools that return no object, such as deletions
+type SuccessResponse struct {
+	Success bool `json:"success"`
+}
+
+// FilterRulesResponse represents the response from the todoist_get_task_filter_rules tool
+type FilterRulesResponse struct {
+	Rules string `json:"rules" jsonschema:"The filter rules as a markdown document"`
+}
+
+// getTasksOutput combines the list and tree responses of the todoist_get_tasks tool
+// for its output schema, as the view decides which of the two is returned
+type getTasksOutput struct {
+	Tasks    []TaskView        `json:"tasks,omitempty"`
+	Projects []ProjectTaskTree `json:"projects,omitempty"`
+}
+
+// recursiveTypes are the parameter and response types that contain themselves. Their schemas
+// are defined once under $defs and referred to, since a recursive type cannot be inlined.
+var recursiveTypes = map[string]reflect.Type{
+	"SubtaskParams":  reflect.TypeFor[SubtaskParams](),
+	"CreatedSubtask": reflect.TypeFor[CreatedSubtask](),
+	"TaskNode":       reflect.TypeFor[TaskNode](),
+}
+
+// integerPattern matches the numeric strings an Integer may be given as
+const integerPattern = `^\s*-?[0-9]+\s*$`
+
+// typeSchemas are the schemas of types that are not described by their Go type
+var typeSchemas = map[reflect.Type]*jsonschema.Schema{
+	reflect.TypeFor[Priority](): {
+		Types:   []string{"integer", "string"},
+		Minimum: jsonschema.Ptr(float64(minPriority)),
+		Maximum: jsonschema.Ptr(float64(maxPriority)),
+		Pattern: fmt.Sprintf("^[pP]?[%d-%d]$", minPriority, maxPriority),
+	},
+	reflect.TypeFor[Integer]():            {Types: []string{"integer", "string"}, Pattern: integerPattern},
+	reflect.TypeFor[Nullable[string]]():   {Types: []string{"null", "string"}},
+	reflect.TypeFor[Nullable[int]]():      {Types: []string{"null", "integer"}},
+	reflect.TypeFor[Nullable[Integer]]():  {Types: []string{"null", "integer", "string"}, Pattern: integerPattern},
+	reflect.TypeFor[Nullable[[]string]](): {Types: []string{"null", "array"}, Items: &jsonschema.Schema{Type: "string"}},
+}
+
+// schemaFor generates the JSON schema of T from the JSON names and jsonschema descriptions
+// of its fields. Fields without omitempty or omitzero are required.
+func schemaFor[T any]() (map[string]interface{}, error) {
+	opts := &jsonschema.ForOptions{TypeSchemas: maps.Clone(typeSchemas)}
+	for name, t := range recursiveTypes {
+		opts.TypeSchemas[reflect.SliceOf(t)] = &jsonschema.Schema{
+			Types: []string{"null", "array"},
+			Items: &jsonschema.Schema{Ref: "#/$defs/" + name},
+		}
+	}
+
+	schema, err := jsonschema.For[T](opts)
+	if err != nil {
+		return nil, err
+	}
+	schemaJSON, err := json.Marshal(schema)
+	if err != nil {
+		return nil, err
+	}
+
+	// Define the recursive types the schema refers to
+	for name, t := range recursiveTypes {
+		if !strings.Contains(string(schemaJSON), `"#/$defs/`+name+`"`) {
+			continue
+		}
+		def, err := jsonschema.ForType(t, opts)
+		if err != nil {
+			return nil, err
+		}
+		if schema.Defs == nil {
+			schema.Defs = map[string]*jsonschema.Schema{}
+		}
+		schema.Defs[name] = def
+	}
+
+	var schemaMap map[string]interface{}
+	if err := remarshal(schema, &schemaMap); err != nil {
+		return nil, err
+	}
+	return schemaMap, nil
+}
+
+// inputSchemaFor returns the input schema of a tool whose arguments are decoded into a T.
+// refine adds keywords that struct tags cannot express, such as enums, to the named properties.
+func inputSchemaFor[T any](refine map[string]map[string]interface{}) (map[string]interface{}, error) {
+	schema, err := schemaFor[T]()
+	if err != nil {
+		return nil, err
+	}
+
+	properties, _ := schema["properties"].(map[string]interface{})
+	for name, keywords := range refine {
+		property, ok := properties[name].(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("schema has no property %s", name)
+		}
+		for keyword, value := range keywords {
+			property[keyword] = value
+		}
+	}
+	return schema, nil
+}
+
+// outputSchema returns the output schema of a tool whose structured content is a T.
+// Properties are never required, so that the compact format, which keeps only some
+// of the fields, conforms to the same schema as the full format.
+func outputSchema[T any]() (json.RawMessage, error) {
+	schema, err := schemaFor[T]()
+	if err != nil {
+		return nil, err
+	}
+	dropRequired(schema)
+	return json.Marshal(schema)
+}
+
+// dropRequired removes the required keywords from a JSON schema and its subschemas
+func dropRequired(schema interface{}) {
+	switch s := schema.(type) {
+	case map[string]interface{}:
+		delete(s, "required")
+		for _, value := range s {
+			dropRequired(value)
+		}
+	case []interface{}:
+		for _, value := range s {
+			dropRequired(value)
+		}
+	}
+}
+
+// remarshal converts a value into another type through its JSON encoding
+func remarshal(from, to interface{}) error {
+	data, err := json.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, to)
+}
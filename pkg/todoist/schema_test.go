@@ -0,0 +1,92 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/google/jsonschema-go/jsonschema"
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// resolvedOutputSchema resolves the output schema of a tool for validation
+func resolvedOutputSchema(t *testing.T, tool mcp.Tool) *jsonschema.Resolved {
+	t.Helper()
+	require.NotNil(t, tool.OutputSchema, "tool %s has no output schema", tool.Name)
+
+	var schema jsonschema.Schema
+	require.NoError(t, json.Unmarshal(tool.OutputSchema.(json.RawMessage), &schema))
+	assert.Equal(t, "object", schema.Type, "tool %s", tool.Name)
+	resolved, err := schema.Resolve(nil)
+	require.NoError(t, err, "tool %s", tool.Name)
+	return resolved
+}
+
+// assertConforms checks that the structured content of a result conforms to the output schema of a tool
+func assertConforms(t *testing.T, tool mcp.Tool, result *mcp.CallToolResult) {
+	t.Helper()
+	require.False(t, result.IsError, resultText(result))
+	require.NotNil(t, result.StructuredContent)
+
+	var content map[string]interface{}
+	require.NoError(t, remarshal(result.StructuredContent, &content))
+	assert.NoError(t, resolvedOutputSchema(t, tool).Validate(content))
+}
+
+func TestOutputSchemas(t *testing.T) {
+	tp := NewMockToolProvider()
+	for _, tool := range tp.GetTools() {
+		resolvedOutputSchema(t, tool.Tool)
+	}
+
+	// Recursive response types are defined once and referred to
+	schema := string(tp.CreateTask().OutputSchema.(json.RawMessage))
+	assert.Contains(t, schema, `"$defs":{"CreatedSubtask"`)
+	assert.NotContains(t, schema, `"required"`)
+}
+
+func TestStructuredContent(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/api/v1/tasks":
+			return MockResponse(200, MockPaginatedTasks([]Task{
+				{ID: "1", ProjectID: "work", Content: "Parent"},
+				{ID: "2", ProjectID: "work", Content: "Child", ParentID: strPtr("1")},
+			})), nil
+		case "/api/v1/tasks/1":
+			return MockResponse(204, nil), nil
+		case "/api/v1/projects":
+			return MockResponse(200, MockPaginatedProjects([]Project{{ID: "work", Name: "Work"}})), nil
+		case "/api/v1/sections":
+			return MockResponse(200, PaginatedResponse[Section]{Results: []Section{}}), nil
+		}
+		return nil, errors.New("unexpected request " + req.URL.Path)
+	})
+	ctx := context.Background()
+
+	for _, params := range []map[string]interface{}{
+		{},
+		{"format": "compact", "fields": []interface{}{"id", "content"}},
+		{"format": "markdown"},
+		{"view": "tree"},
+		{"view": "tree", "format": "compact"},
+	} {
+		result, err := CallTypedTool(ctx, tp.HandleGetTasks, MockCallToolRequest(params))
+		require.NoError(t, err)
+		assertConforms(t, tp.GetTasks(), result)
+	}
+
+	result, err := CallTypedTool(ctx, tp.HandleDeleteTask, MockCallToolRequest(map[string]interface{}{"id": "1"}))
+	require.NoError(t, err)
+	assertConforms(t, tp.DeleteTask(), result)
+	assert.Equal(t, SuccessResponse{Success: true}, result.StructuredContent)
+
+	result, err = tp.HandleGetTaskFilterRules(ctx, MockCallToolRequest(map[string]interface{}{}))
+	require.NoError(t, err)
+	assertConforms(t, tp.GetTaskFilterRules(), result)
+	assert.Equal(t, resultText(result), result.StructuredContent.(FilterRulesResponse).Rules)
+}
@@ -0,0 +1,585 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// GetSectionsResponse represents the response from the todoist_get_sections tool
+type GetSectionsResponse struct {
+	Sections []Section `json:"sections"`
+}
+
+// GetSectionResponse represents the response from the todoist_get_section tool
+type GetSectionResponse struct {
+	Section Section `json:"section"`
+}
+
+// CreateSectionResponse represents the response from the todoist_create_section tool
+type CreateSectionResponse struct {
+	Section Section `json:"section"`
+}
+
+// UpdateSectionResponse represents the response from the todoist_update_section tool
+type UpdateSectionResponse struct {
+	Section Section `json:"section"`
+}
+
+// MoveTaskToSectionResponse represents the response from the todoist_move_task_to_section tool
+type MoveTaskToSectionResponse struct {
+	Task TaskView `json:"task"`
+}
+
+// GetSections returns the todoist_get_sections tool
+func (tp *ToolProvider) GetSections() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type": "object",
+		"properties": map[string]interface{}{
+			"projectId": map[string]interface{}{
+				"type":        "string",
+				"description": "Filter sections by project ID. If not specified, sections from all projects are returned.",
+			},
+		},
+	}
+
+	addFormatProperties(inputSchema, sectionRenderer)
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetSectionsResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_get_sections",
+		Description:  "Get a list of sections.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetSections handles the todoist_get_sections tool request
+func (tp *ToolProvider) HandleGetSections(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, sectionRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	projectID, err := OptionalParam[string](request, "projectId")
+	if err != nil {
+		return newToolResultError("Invalid parameter: projectId", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("projectId", projectID).Info("Getting sections")
+
+	// Call the Todoist API
+	sections, err := tp.client.GetSections(ctx, projectID)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get sections")
+		return newToolResultError("Failed to get sections", err), nil
+	}
+
+	// Convert sections to JSON
+	response := GetSectionsResponse{
+		Sections: sections,
+	}
+
+	// Return the response in the requested format
+	return renderList(format, sectionRenderer, response, "sections", sections, nil), nil
+}
+
+// GetSection returns the todoist_get_section tool
+func (tp *ToolProvider) GetSection() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the section to retrieve. Specify the numeric Todoist section ID (e.g., '7025').",
+			},
+		},
+	}
+
+	addFormatProperties(inputSchema, sectionRenderer)
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetSectionResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_get_section",
+		Description:  "Get a section by ID.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetSection handles the todoist_get_section tool request
+func (tp *ToolProvider) HandleGetSection(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, sectionRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Getting section")
+
+	// Call the Todoist API
+	section, err := tp.client.GetSection(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get section")
+		return newToolResultError("Failed to get section", err), nil
+	}
+
+	// Convert section to JSON
+	response := GetSectionResponse{
+		Section: *section,
+	}
+
+	// Return the response in the requested format
+	return renderObject(format, sectionRenderer, response, "section", *section), nil
+}
+
+// CreateSection returns the todoist_create_section tool
+func (tp *ToolProvider) CreateSection() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"name", "projectId"},
+		"properties": map[string]interface{}{
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "The name of the section (required).",
+			},
+			"projectId": map[string]interface{}{
+				"type":        "string",
+				"description": "Project ID the section belongs to (required).",
+			},
+			"order": map[string]interface{}{
+				"type":        "integer",
+				"description": "Order value for positioning the section within its project. Sections are sorted by this value in ascending order.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[CreateSectionResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_create_section",
+		Description:  "Create a new section in a project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleCreateSection handles the todoist_create_section tool request
+func (tp *ToolProvider) HandleCreateSection(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	name, err := RequiredParam[string](request, "name")
+	if err != nil {
+		return newToolResultError("Missing required parameter: name", err), nil
+	}
+
+	projectID, err := RequiredParam[string](request, "projectId")
+	if err != nil {
+		return newToolResultError("Missing required parameter: projectId", err), nil
+	}
+
+	order, err := OptionalPtrParam[int](request, "order")
+	if err != nil {
+		return newToolResultError("Invalid parameter: order", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"name":      name,
+		"projectId": projectID,
+	}).Info("Creating section")
+
+	// Create request
+	createReq := CreateSectionRequest{
+		Name:      name,
+		ProjectID: projectID,
+		Order:     order,
+	}
+
+	// Call the Todoist API
+	section, err := tp.client.CreateSection(ctx, createReq)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to create section")
+		return newToolResultError("Failed to create section", err), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Convert section to JSON
+	response := CreateSectionResponse{
+		Section: *section,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// UpdateSection returns the todoist_update_section tool
+func (tp *ToolProvider) UpdateSection() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id", "name"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the section to rename (required). Specify the numeric Todoist section ID (e.g., '7025').",
+			},
+			"name": map[string]interface{}{
+				"type":        "string",
+				"description": "The new name of the section (required).",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[UpdateSectionResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_update_section",
+		Description:  "Rename an existing section.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleUpdateSection handles the todoist_update_section tool request
+func (tp *ToolProvider) HandleUpdateSection(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	name, err := RequiredParam[string](request, "name")
+	if err != nil {
+		return newToolResultError("Missing required parameter: name", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"id":   id,
+		"name": name,
+	}).Info("Updating section")
+
+	// Call the Todoist API
+	section, err := tp.client.UpdateSection(ctx, id, UpdateSectionRequest{Name: name})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to update section")
+		return newToolResultError("Failed to update section", err), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Convert section to JSON
+	response := UpdateSectionResponse{
+		Section: *section,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// ReorderSections returns the todoist_reorder_sections tool
+func (tp *ToolProvider) ReorderSections() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"sections"},
+		"properties": map[string]interface{}{
+			"sections": map[string]interface{}{
+				"type":        "array",
+				"description": "The sections to reposition (required), each with its ID and new order value. Sections are sorted by order in ascending order within their project.",
+				"minItems":    1,
+				"items": map[string]interface{}{
+					"type":     "object",
+					"required": []string{"id", "order"},
+					"properties": map[string]interface{}{
+						"id": map[string]interface{}{
+							"type":        "string",
+							"description": "The unique identifier of the section.",
+						},
+						"order": map[string]interface{}{
+							"type":        "integer",
+							"description": "The new order value of the section.",
+						},
+					},
+				},
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_reorder_sections",
+		Description:  "Change the order of sections within a project.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleReorderSections handles the todoist_reorder_sections tool request
+func (tp *ToolProvider) HandleReorderSections(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	orders, err := sectionOrdersParam(request, "sections")
+	if err != nil {
+		return newToolResultError("Invalid parameter: sections", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("sections", len(orders)).Info("Reordering sections")
+
+	// Call the Todoist API
+	err = tp.client.ReorderSections(ctx, orders)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to reorder sections")
+		return newToolResultError("Failed to reorder sections", err), nil
+	}
+
+	// Return success response
+	return jsonResult(SuccessResponse{Success: true}), nil
+}
+
+// DeleteSection returns the todoist_delete_section tool
+func (tp *ToolProvider) DeleteSection() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the section to delete (required). Specify the numeric Todoist section ID (e.g., '7025'). Warning: All tasks in the section are deleted too. This action is permanent and cannot be undone.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_delete_section",
+		Description:  "Delete a section and all of its tasks.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleDeleteSection handles the todoist_delete_section tool request
+func (tp *ToolProvider) HandleDeleteSection(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithField("id", id).Info("Deleting section")
+
+	// Call the Todoist API
+	err = tp.client.DeleteSection(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to delete section")
+		return newToolResultError("Failed to delete section", err), nil
+	}
+
+	// Drop cached project and section names
+	tp.names.invalidate()
+
+	// Return success response
+	return jsonResult(SuccessResponse{Success: true}), nil
+}
+
+// MoveTaskToSection returns the todoist_move_task_to_section tool
+func (tp *ToolProvider) MoveTaskToSection() mcp.Tool {
+	// Define the input schema for the tool
+	inputSchema := map[string]interface{}{
+		"type":     "object",
+		"required": []string{"id", "sectionId"},
+		"properties": map[string]interface{}{
+			"id": map[string]interface{}{
+				"type":        "string",
+				"description": "The unique identifier of the task to move (required). Specify the numeric Todoist task ID (e.g., '2995104339').",
+			},
+			"sectionId": map[string]interface{}{
+				"type":        "string",
+				"description": "The section ID to move the task into (required). The section may belong to a different project; the task moves along with it.",
+			},
+		},
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[MoveTaskToSectionResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_move_task_to_section",
+		Description:  "Move a task into a section.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleMoveTaskToSection handles the todoist_move_task_to_section tool request
+func (tp *ToolProvider) HandleMoveTaskToSection(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	id, err := RequiredParam[string](request, "id")
+	if err != nil {
+		return newToolResultError("Missing required parameter: id", err), nil
+	}
+
+	sectionID, err := RequiredParam[string](request, "sectionId")
+	if err != nil {
+		return newToolResultError("Missing required parameter: sectionId", err), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"id":        id,
+		"sectionId": sectionID,
+	}).Info("Moving task to section")
+
+	// Call the Todoist API
+	task, err := tp.client.MoveTask(ctx, id, MoveTaskRequest{SectionID: sectionID})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to move task to section")
+		return newToolResultAPIError("Failed to move task to section", err, "task or section", id), nil
+	}
+
+	// Convert task to JSON
+	response := MoveTaskToSectionResponse{
+		Task: newTaskView(*task),
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// sectionOrdersParam parses an array of {id, order} objects from the request
+func sectionOrdersParam(r *mcp.CallToolRequest, p string) ([]SectionOrder, error) {
+	args, err := getArguments(r)
+	if err != nil {
+		return nil, err
+	}
+
+	items, ok := args[p].([]interface{})
+	if !ok || len(items) == 0 {
+		return nil, fmt.Errorf("parameter %s must be a non-empty array", p)
+	}
+
+	orders := make([]SectionOrder, len(items))
+	for i, item := range items {
+		obj, ok := item.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("parameter %s[%d] is not an object", p, i)
+		}
+		id, ok := obj["id"].(string)
+		if !ok || id == "" {
+			return nil, fmt.Errorf("parameter %s[%d].id must be a non-empty string", p, i)
+		}
+		order, err := intValue(obj["order"])
+		if err != nil {
+			return nil, fmt.Errorf("parameter %s[%d].order %w", p, i, err)
+		}
+		orders[i] = SectionOrder{ID: id, SectionOrder: order}
+	}
+
+	return orders, nil
+}
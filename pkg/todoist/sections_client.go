@@ -0,0 +1,140 @@
+package todoist
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"net/http"
+	"net/url"
+)
+
+// GetSections retrieves all sections, optionally limited to a single project,
+// following next_cursor across pages
+func (c *Client) GetSections(ctx context.Context, projectID string) ([]Section, error) {
+	return collect(c.AllSections(ctx, projectID))
+}
+
+// AllSections returns an iterator over sections that fetches pages lazily
+func (c *Client) AllSections(ctx context.Context, projectID string) iter.Seq2[Section, error] {
+	params := url.Values{}
+	if projectID != "" {
+		params.Set("project_id", projectID)
+	}
+
+	return paginate[Section](ctx, c, "/sections", params, "get sections")
+}
+
+// GetSection retrieves a specific section by ID
+func (c *Client) GetSection(ctx context.Context, id string) (*Section, error) {
+	endpoint := fmt.Sprintf("/sections/%s", id)
+
+	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get section: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var section Section
+	if err := json.Unmarshal(bodyBytes, &section); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &section, nil
+}
+
+// CreateSection creates a new section in a project
+func (c *Client) CreateSection(ctx context.Context, req CreateSectionRequest) (*Section, error) {
+	endpoint := "/sections"
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create section: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var section Section
+	if err := json.Unmarshal(bodyBytes, &section); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &section, nil
+}
+
+// UpdateSection updates (renames) an existing section
+func (c *Client) UpdateSection(ctx context.Context, id string, req UpdateSectionRequest) (*Section, error) {
+	endpoint := fmt.Sprintf("/sections/%s", id)
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to update section: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var section Section
+	if err := json.Unmarshal(bodyBytes, &section); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &section, nil
+}
+
+// ReorderSections sets the position of sections within their project.
+// The REST API has no reorder endpoint, so this is sent as a section_reorder Sync command.
+func (c *Client) ReorderSections(ctx context.Context, orders []SectionOrder) error {
+	command := newSyncCommand("section_reorder", map[string]interface{}{
+		"sections": orders,
+	})
+
+	syncResp, err := c.executeCommands(ctx, []syncCommand{command})
+	if err != nil {
+		return fmt.Errorf("failed to reorder sections: %w", err)
+	}
+
+	if err := commandStatusError(syncResp.SyncStatus[command.UUID]); err != nil {
+		return fmt.Errorf("failed to reorder sections: %w", err)
+	}
+
+	return nil
+}
+
+// DeleteSection deletes a section and all tasks within it
+func (c *Client) DeleteSection(ctx context.Context, id string) error {
+	endpoint := fmt.Sprintf("/sections/%s", id)
+
+	resp, err := c.doRequest(ctx, "DELETE", endpoint, nil)
+	if err != nil {
+		return fmt.Errorf("failed to delete section: %w", err)
+	}
+
+	_, err = c.processResponse(resp, http.StatusNoContent)
+	return err
+}
@@ -0,0 +1,216 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGetSectionsTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.GetSections()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_get_sections", tool.Name)
+	assert.Equal(t, "Get a list of sections.", tool.Description)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+}
+
+func TestHandleGetSections(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "987654321", req.URL.Query().Get("project_id"))
+		return MockResponse(200, PaginatedResponse[Section]{Results: []Section{*MockSection()}}), nil
+	})
+
+	result, err := tp.HandleGetSections(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"projectId": "987654321",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var response GetSectionsResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Len(t, response.Sections, 1)
+}
+
+func TestHandleGetSection(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		return MockResponse(200, MockSection()), nil
+	})
+
+	result, err := tp.HandleGetSection(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id": "7025",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var response GetSectionResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Equal(t, "7025", response.Section.ID)
+}
+
+func TestCreateSectionTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.CreateSection()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_create_section", tool.Name)
+
+	// Check input schema
+	var schema map[string]interface{}
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+	err = json.Unmarshal(schemaBytes, &schema)
+	assert.NoError(t, err)
+
+	required, ok := schema["required"].([]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, required, "name")
+	assert.Contains(t, required, "projectId")
+}
+
+func TestHandleCreateSection(t *testing.T) {
+	var body CreateSectionRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockSection()), nil
+	})
+
+	// projectId is required
+	result, err := tp.HandleCreateSection(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name": "In Progress",
+	}))
+	assert.NoError(t, err)
+	assert.True(t, result.IsError)
+
+	result, err = tp.HandleCreateSection(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"name":      "In Progress",
+		"projectId": "987654321",
+		"order":     2,
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, CreateSectionRequest{Name: "In Progress", ProjectID: "987654321", Order: intPtr(2)}, body)
+}
+
+func TestHandleUpdateSection(t *testing.T) {
+	var body UpdateSectionRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockSection()), nil
+	})
+
+	result, err := tp.HandleUpdateSection(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":   "7025",
+		"name": "Done",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "Done", body.Name)
+}
+
+func TestHandleReorderSections(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, req.ParseForm())
+		var commands []syncCommand
+		assert.NoError(t, json.Unmarshal([]byte(req.PostForm.Get("commands")), &commands))
+		return MockResponse(200, map[string]interface{}{
+			"sync_status": map[string]interface{}{commands[0].UUID: "ok"},
+		}), nil
+	})
+
+	tests := []struct {
+		name      string
+		params    map[string]interface{}
+		wantError bool
+	}{
+		{
+			name: "success",
+			params: map[string]interface{}{
+				"sections": []interface{}{
+					map[string]interface{}{"id": "7025", "order": 2},
+					map[string]interface{}{"id": "7026", "order": 1},
+				},
+			},
+			wantError: false,
+		},
+		{
+			name:      "missing sections",
+			params:    map[string]interface{}{},
+			wantError: true,
+		},
+		{
+			name: "missing order",
+			params: map[string]interface{}{
+				"sections": []interface{}{
+					map[string]interface{}{"id": "7025"},
+				},
+			},
+			wantError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tp.HandleReorderSections(context.Background(), MockCallToolRequest(tt.params))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantError, result.IsError)
+		})
+	}
+}
+
+func TestHandleDeleteSection(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "DELETE", req.Method)
+		return MockResponse(204, nil), nil
+	})
+
+	result, err := tp.HandleDeleteSection(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id": "7025",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+}
+
+func TestHandleMoveTaskToSection(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.Equal(t, "/api/v1/tasks/123456789/move", req.URL.Path)
+		return MockResponse(200, MockTask()), nil
+	})
+
+	result, err := tp.HandleMoveTaskToSection(context.Background(), MockCallToolRequest(map[string]interface{}{
+		"id":        "123456789",
+		"sectionId": "7025",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+
+	var response MoveTaskToSectionResponse
+	assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Equal(t, "123456789", response.Task.ID)
+}
+
+func TestHandleCreateTaskWithSection(t *testing.T) {
+	var body CreateTaskRequest
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		return MockResponse(200, MockTask()), nil
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(map[string]interface{}{
+		"content":   "Write spec",
+		"sectionId": "7025",
+	}))
+	assert.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.Equal(t, "7025", body.SectionID)
+}
@@ -2,10 +2,12 @@ package todoist
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
+	todoistsync "github.com/naotama2002/todoist-go-mcp-server/pkg/todoist/sync"
 	"github.com/naotama2002/todoist-go-mcp-server/pkg/toolsets"
 	"github.com/sirupsen/logrus"
 )
@@ -17,10 +19,13 @@ type Server struct {
 	logger       *logrus.Logger
 	httpServer   *http.Server
 	toolsetGroup *toolsets.ToolsetGroup
+	// dynamicToolsets adds the meta-tools that enable further toolsets during a session
+	dynamicToolsets bool
 }
 
-// NewServer creates a new Todoist MCP server
-func NewServer(token string, logger *logrus.Logger) *Server {
+// NewServer creates a new Todoist MCP server.
+// The options configure the Todoist API client, e.g. WithRateLimit.
+func NewServer(token string, logger *logrus.Logger, options ...ClientOption) *Server {
 	if logger == nil {
 		logger = logrus.New()
 		logger.SetFormatter(&logrus.TextFormatter{
@@ -28,7 +33,7 @@ func NewServer(token string, logger *logrus.Logger) *Server {
 		})
 	}
 
-	tools := NewToolProvider(token, logger)
+	tools := NewToolProvider(token, logger, options...)
 
 	// Create a new MCP server with default options
 	mcpServer := mcp.NewServer(
@@ -39,8 +44,11 @@ func NewServer(token string, logger *logrus.Logger) *Server {
 		nil,
 	)
 
-	// Create default toolset group
+	// Create default toolset group, with all toolsets enabled
 	toolsetGroup := createDefaultToolsetGroup(tools, false)
+	if err := toolsetGroup.EnableToolsets([]string{"all"}); err != nil {
+		logger.WithError(err).Error("Failed to enable toolsets")
+	}
 
 	return &Server{
 		mcpServer:    mcpServer,
@@ -50,7 +58,33 @@ func NewServer(token string, logger *logrus.Logger) *Server {
 	}
 }
 
-// createDefaultToolsetGroup creates the default toolset group for Todoist
+// EnableSyncMirror serves the read tools from a local mirror of the account, kept up to date
+// with incremental Sync API requests. Reads sync the mirror when it is older than maxStaleness
+// or a write tool changed data. If cacheFile is set, the mirror is persisted there between runs.
+func (s *Server) EnableSyncMirror(maxStaleness time.Duration, cacheFile string) error {
+	client, ok := s.tools.client.(todoistsync.Requester)
+	if !ok {
+		return fmt.Errorf("the Todoist client does not support the Sync API")
+	}
+
+	options := []todoistsync.Option{todoistsync.WithLogger(s.logger)}
+	if cacheFile != "" {
+		options = append(options, todoistsync.WithPersistPath(cacheFile))
+	}
+	mirror, err := todoistsync.New(client, options...)
+	if err != nil {
+		return fmt.Errorf("failed to create sync mirror: %w", err)
+	}
+
+	s.tools.UseSyncMirror(mirror, maxStaleness)
+	s.logger.WithFields(logrus.Fields{
+		"maxStaleness": maxStaleness,
+		"cacheFile":    cacheFile,
+	}).Info("Serving read tools from the Sync API mirror")
+	return nil
+}
+
+// createDefaultToolsetGroup creates the default toolset group for Todoist, with no toolset enabled
 func createDefaultToolsetGroup(tp *ToolProvider, readOnly bool) *toolsets.ToolsetGroup {
 	group := toolsets.NewToolsetGroup(readOnly)
 
@@ -58,43 +92,149 @@ func createDefaultToolsetGroup(tp *ToolProvider, readOnly bool) *toolsets.Toolse
 	taskToolset := toolsets.NewToolset("tasks", "Todoist task management tools")
 	taskToolset.AddReadTools(
 		toolsets.NewServerTool(tp.GetTaskFilterRules(), tp.HandleGetTaskFilterRules),
-		toolsets.NewServerTool(tp.GetTasks(), tp.HandleGetTasks),
-		toolsets.NewServerTool(tp.GetTask(), tp.HandleGetTask),
+		toolsets.NewTypedServerTool(tp.GetTasks(), tp.HandleGetTasks),
+		toolsets.NewTypedServerTool(tp.GetTask(), tp.HandleGetTask),
+		toolsets.NewTypedServerTool(tp.GetCompletedTasks(), tp.HandleGetCompletedTasks),
 	)
 
 	if !readOnly {
-		taskToolset.AddWriteTools(
-			toolsets.NewServerTool(tp.CreateTask(), tp.HandleCreateTask),
-			toolsets.NewServerTool(tp.UpdateTask(), tp.HandleUpdateTask),
-			toolsets.NewServerTool(tp.CloseTask(), tp.HandleCloseTask),
-			toolsets.NewServerTool(tp.DeleteTask(), tp.HandleDeleteTask),
-		)
+		taskToolset.AddWriteTools(tp.writeTools(
+			toolsets.NewTypedServerTool(tp.CreateTask(), tp.HandleCreateTask),
+			toolsets.NewTypedServerTool(tp.QuickAddTask(), tp.HandleQuickAddTask),
+			toolsets.NewTypedServerTool(tp.UpdateTask(), tp.HandleUpdateTask),
+			toolsets.NewTypedServerTool(tp.CloseTask(), tp.HandleCloseTask),
+			toolsets.NewTypedServerTool(tp.ReopenTask(), tp.HandleReopenTask),
+			toolsets.NewTypedServerTool(tp.DeleteTask(), tp.HandleDeleteTask),
+		)...)
 	}
 
 	// Create project management toolset
 	projectToolset := toolsets.NewToolset("projects", "Todoist project management tools")
 	projectToolset.AddReadTools(
-		toolsets.NewServerTool(tp.GetProjects(), tp.HandleGetProjects),
-		toolsets.NewServerTool(tp.GetProject(), tp.HandleGetProject),
+		toolsets.NewTypedServerTool(tp.GetProjects(), tp.HandleGetProjects),
+		toolsets.NewTypedServerTool(tp.GetProject(), tp.HandleGetProject),
+	)
+
+	if !readOnly {
+		projectToolset.AddWriteTools(tp.writeTools(
+			toolsets.NewTypedServerTool(tp.CreateProject(), tp.HandleCreateProject),
+			toolsets.NewTypedServerTool(tp.UpdateProject(), tp.HandleUpdateProject),
+			toolsets.NewTypedServerTool(tp.ArchiveProject(), tp.HandleArchiveProject),
+			toolsets.NewTypedServerTool(tp.UnarchiveProject(), tp.HandleUnarchiveProject),
+			toolsets.NewTypedServerTool(tp.DeleteProject(), tp.HandleDeleteProject),
+		)...)
+	}
+
+	// Create label management toolset
+	labelToolset := toolsets.NewToolset("labels", "Todoist label management tools")
+	labelToolset.AddReadTools(
+		toolsets.NewServerTool(tp.GetLabels(), tp.HandleGetLabels),
+		toolsets.NewServerTool(tp.GetLabel(), tp.HandleGetLabel),
+	)
+
+	if !readOnly {
+		labelToolset.AddWriteTools(tp.writeTools(
+			toolsets.NewServerTool(tp.CreateLabel(), tp.HandleCreateLabel),
+			toolsets.NewServerTool(tp.UpdateLabel(), tp.HandleUpdateLabel),
+			toolsets.NewServerTool(tp.DeleteLabel(), tp.HandleDeleteLabel),
+			toolsets.NewServerTool(tp.RenameSharedLabel(), tp.HandleRenameSharedLabel),
+		)...)
+	}
+
+	// Create section management toolset
+	sectionToolset := toolsets.NewToolset("sections", "Todoist section management tools")
+	sectionToolset.AddReadTools(
+		toolsets.NewServerTool(tp.GetSections(), tp.HandleGetSections),
+		toolsets.NewServerTool(tp.GetSection(), tp.HandleGetSection),
+	)
+
+	if !readOnly {
+		sectionToolset.AddWriteTools(tp.writeTools(
+			toolsets.NewServerTool(tp.CreateSection(), tp.HandleCreateSection),
+			toolsets.NewServerTool(tp.UpdateSection(), tp.HandleUpdateSection),
+			toolsets.NewServerTool(tp.ReorderSections(), tp.HandleReorderSections),
+			toolsets.NewServerTool(tp.DeleteSection(), tp.HandleDeleteSection),
+			toolsets.NewServerTool(tp.MoveTaskToSection(), tp.HandleMoveTaskToSection),
+		)...)
+	}
+
+	// Create comment management toolset
+	commentToolset := toolsets.NewToolset("comments", "Todoist task and project comment tools")
+	commentToolset.AddReadTools(
+		toolsets.NewServerTool(tp.GetComments(), tp.HandleGetComments),
 	)
 
+	if !readOnly {
+		commentToolset.AddWriteTools(tp.writeTools(
+			toolsets.NewServerTool(tp.AddComment(), tp.HandleAddComment),
+			toolsets.NewServerTool(tp.UpdateComment(), tp.HandleUpdateComment),
+			toolsets.NewServerTool(tp.DeleteComment(), tp.HandleDeleteComment),
+		)...)
+	}
+
+	// Create batch toolset
+	batchToolset := toolsets.NewToolset("batch", "Todoist tools that combine several operations in one request")
+
+	if !readOnly {
+		batchToolset.AddWriteTools(tp.writeTools(
+			toolsets.NewServerTool(tp.Batch(), tp.HandleBatch),
+		)...)
+	}
+
 	// Add toolsets to the group
 	group.AddToolset(taskToolset)
 	group.AddToolset(projectToolset)
+	group.AddToolset(labelToolset)
+	group.AddToolset(sectionToolset)
+	group.AddToolset(commentToolset)
+	group.AddToolset(batchToolset)
+
+	return group
+}
 
-	// Enable all toolsets by default
-	if err := group.EnableToolsets([]string{"all"}); err != nil {
-		return nil
+// ConfigureToolsets selects the toolsets whose tools the server exposes, by name or "all".
+// In read-only mode, only the tools that do not change any data are exposed.
+// With dynamic toolsets, no toolset needs to be selected, as clients can enable them later.
+// It must be called before the server is started.
+func (s *Server) ConfigureToolsets(names []string, readOnly bool) error {
+	if len(names) == 0 && !s.dynamicToolsets {
+		return fmt.Errorf("no toolsets selected")
 	}
 
-	return group
+	group := createDefaultToolsetGroup(s.tools, readOnly)
+	if err := group.EnableToolsets(names); err != nil {
+		return err
+	}
+	s.toolsetGroup = group
+
+	s.logger.WithFields(logrus.Fields{
+		"toolsets": names,
+		"readOnly": readOnly,
+	}).Info("Configured toolsets")
+	return nil
+}
+
+// EnableDynamicToolsets adds the list_available_toolsets, get_toolset_tools and enable_toolset
+// meta-tools, with which clients enable further toolsets during a session instead of being
+// sent every tool up front. It must be called before ConfigureToolsets.
+func (s *Server) EnableDynamicToolsets() {
+	s.dynamicToolsets = true
+}
+
+// registerTools registers the tools of the enabled toolsets with the MCP server,
+// together with the meta-tools in dynamic mode
+func (s *Server) registerTools() {
+	s.toolsetGroup.RegisterTools(s.mcpServer)
+	if s.dynamicToolsets {
+		newDynamicToolsets(s.mcpServer, s.toolsetGroup, s.logger).Toolset().RegisterTools(s.mcpServer)
+	}
+	s.logger.WithField("dynamicToolsets", s.dynamicToolsets).Info("Registered tools from toolset group")
 }
 
 // Start starts the Todoist MCP server over HTTP
 func (s *Server) Start(ctx context.Context, addr string) error {
 	// Register tools using toolset group
-	s.toolsetGroup.RegisterTools(s.mcpServer)
-	s.logger.Info("Registered tools from toolset group")
+	s.registerTools()
 
 	// Create HTTP server with StreamableHTTPHandler
 	handler := mcp.NewStreamableHTTPHandler(
@@ -136,8 +276,7 @@ func (s *Server) Start(ctx context.Context, addr string) error {
 // StartStdio starts the Todoist MCP server over stdio
 func (s *Server) StartStdio(ctx context.Context) error {
 	// Register tools using toolset group
-	s.toolsetGroup.RegisterTools(s.mcpServer)
-	s.logger.Info("Registered tools from toolset group")
+	s.registerTools()
 
 	s.logger.Info("Starting Todoist MCP server over stdio")
 	return s.mcpServer.Run(ctx, &mcp.StdioTransport{})
@@ -3,10 +3,13 @@ package todoist
 import (
 	"context"
 	"encoding/json"
+	"net/http"
 	"testing"
 
+	"github.com/modelcontextprotocol/go-sdk/mcp"
 	"github.com/sirupsen/logrus"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestNewServer(t *testing.T) {
@@ -28,7 +31,7 @@ func TestGetTools(t *testing.T) {
 
 	// Check that the tools were returned correctly
 	assert.NotNil(t, tools)
-	assert.Len(t, tools, 9) // 9 tools: get_tasks, get_task, create_task, update_task, close_task, delete_task, get_projects, get_project, get_task_filter_rules
+	assert.Len(t, tools, 35) // 17 task/project tools, 6 label tools, 7 section tools, 4 comment tools and the batch tool
 
 	// Check that the tools have the correct names
 	toolNames := make([]string, len(tools))
@@ -37,13 +40,248 @@ func TestGetTools(t *testing.T) {
 	}
 	assert.Contains(t, toolNames, "todoist_get_tasks")
 	assert.Contains(t, toolNames, "todoist_get_task")
+	assert.Contains(t, toolNames, "todoist_get_completed_tasks")
 	assert.Contains(t, toolNames, "todoist_create_task")
+	assert.Contains(t, toolNames, "todoist_quick_add_task")
 	assert.Contains(t, toolNames, "todoist_update_task")
 	assert.Contains(t, toolNames, "todoist_close_task")
+	assert.Contains(t, toolNames, "todoist_reopen_task")
 	assert.Contains(t, toolNames, "todoist_delete_task")
 	assert.Contains(t, toolNames, "todoist_get_projects")
 	assert.Contains(t, toolNames, "todoist_get_project")
+	assert.Contains(t, toolNames, "todoist_create_project")
+	assert.Contains(t, toolNames, "todoist_update_project")
+	assert.Contains(t, toolNames, "todoist_archive_project")
+	assert.Contains(t, toolNames, "todoist_unarchive_project")
+	assert.Contains(t, toolNames, "todoist_delete_project")
 	assert.Contains(t, toolNames, "todoist_get_task_filter_rules")
+	assert.Contains(t, toolNames, "todoist_get_labels")
+	assert.Contains(t, toolNames, "todoist_get_label")
+	assert.Contains(t, toolNames, "todoist_create_label")
+	assert.Contains(t, toolNames, "todoist_update_label")
+	assert.Contains(t, toolNames, "todoist_delete_label")
+	assert.Contains(t, toolNames, "todoist_rename_shared_label")
+	assert.Contains(t, toolNames, "todoist_get_sections")
+	assert.Contains(t, toolNames, "todoist_get_section")
+	assert.Contains(t, toolNames, "todoist_create_section")
+	assert.Contains(t, toolNames, "todoist_update_section")
+	assert.Contains(t, toolNames, "todoist_reorder_sections")
+	assert.Contains(t, toolNames, "todoist_delete_section")
+	assert.Contains(t, toolNames, "todoist_move_task_to_section")
+	assert.Contains(t, toolNames, "todoist_get_comments")
+	assert.Contains(t, toolNames, "todoist_add_comment")
+	assert.Contains(t, toolNames, "todoist_update_comment")
+	assert.Contains(t, toolNames, "todoist_delete_comment")
+	assert.Contains(t, toolNames, "todoist_batch")
+}
+
+func TestCreateDefaultToolsetGroupReadOnly(t *testing.T) {
+	tp := NewMockToolProvider()
+
+	// Write tools are only exposed when not in read-only mode
+	activeToolNames := func(readOnly bool) []string {
+		group := createDefaultToolsetGroup(tp, readOnly)
+		require.NoError(t, group.EnableToolsets([]string{"projects"}))
+		var names []string
+		for _, tool := range group.Toolsets["projects"].GetActiveTools() {
+			names = append(names, tool.Tool.Name)
+		}
+		return names
+	}
+
+	names := activeToolNames(false)
+	assert.Contains(t, names, "todoist_get_projects")
+	assert.Contains(t, names, "todoist_create_project")
+	assert.Contains(t, names, "todoist_archive_project")
+
+	names = activeToolNames(true)
+	assert.Contains(t, names, "todoist_get_projects")
+	assert.NotContains(t, names, "todoist_create_project")
+	assert.NotContains(t, names, "todoist_archive_project")
+	assert.NotContains(t, names, "todoist_delete_project")
+}
+
+func TestConfigureToolsets(t *testing.T) {
+	// listTools returns the names of the tools the server exposes to a client
+	listTools := func(t *testing.T, server *Server) []string {
+		server.toolsetGroup.RegisterTools(server.mcpServer)
+		ctx := context.Background()
+		serverTransport, clientTransport := mcp.NewInMemoryTransports()
+		serverSession, err := server.mcpServer.Connect(ctx, serverTransport, nil)
+		require.NoError(t, err)
+		defer func() { assert.NoError(t, serverSession.Close()) }()
+		session, err := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.1"}, nil).Connect(ctx, clientTransport, nil)
+		require.NoError(t, err)
+		defer func() { assert.NoError(t, session.Close()) }()
+
+		result, err := session.ListTools(ctx, nil)
+		require.NoError(t, err)
+		var names []string
+		for _, tool := range result.Tools {
+			names = append(names, tool.Name)
+		}
+		return names
+	}
+
+	t.Run("all toolsets by default", func(t *testing.T) {
+		names := listTools(t, NewServer("test-token", logrus.New()))
+		for _, name := range []string{"todoist_get_tasks", "todoist_create_task", "todoist_create_project", "todoist_get_labels", "todoist_move_task_to_section", "todoist_add_comment", "todoist_batch"} {
+			assert.Contains(t, names, name)
+		}
+		assert.NotContains(t, names, "enable_toolset", "meta-tools are only added with dynamic toolsets")
+	})
+
+	t.Run("selected toolsets", func(t *testing.T) {
+		server := NewServer("test-token", logrus.New())
+		require.NoError(t, server.ConfigureToolsets([]string{"projects", "labels"}, false))
+		names := listTools(t, server)
+		for _, name := range []string{"todoist_get_projects", "todoist_create_project", "todoist_get_labels", "todoist_rename_shared_label"} {
+			assert.Contains(t, names, name)
+		}
+		for _, name := range []string{"todoist_get_tasks", "todoist_get_sections", "todoist_get_comments", "todoist_batch"} {
+			assert.NotContains(t, names, name)
+		}
+	})
+
+	t.Run("read-only", func(t *testing.T) {
+		server := NewServer("test-token", logrus.New())
+		require.NoError(t, server.ConfigureToolsets([]string{"all"}, true))
+		names := listTools(t, server)
+		assert.Contains(t, names, "todoist_get_tasks")
+		assert.Contains(t, names, "todoist_get_comments")
+		assert.NotContains(t, names, "todoist_create_task")
+		assert.NotContains(t, names, "todoist_batch")
+		for _, name := range names {
+			assert.NotRegexp(t, "_(create|update|delete|close|reopen|archive|unarchive|add|rename|reorder|move)_", name)
+		}
+	})
+
+	t.Run("read-only toolset without tools", func(t *testing.T) {
+		server := NewServer("test-token", logrus.New())
+		err := server.ConfigureToolsets([]string{"tasks", "batch"}, true)
+		assert.EqualError(t, err, "toolsets without tools in read-only mode: batch")
+	})
+
+	t.Run("unknown toolsets", func(t *testing.T) {
+		server := NewServer("test-token", logrus.New())
+		err := server.ConfigureToolsets([]string{"tasks", "filters", "notes"}, false)
+		assert.EqualError(t, err, "unknown toolsets: filters, notes (available: batch, comments, labels, projects, sections, tasks, all)")
+
+		// The previous selection is kept
+		assert.True(t, server.toolsetGroup.IsEnabled("labels"))
+
+		assert.EqualError(t, server.ConfigureToolsets(nil, false), "no toolsets selected")
+	})
+}
+
+// connectToolProvider serves all tools of tp over an in-memory connection and returns
+// the client session
+func connectToolProvider(t *testing.T, tp *ToolProvider) *mcp.ClientSession {
+	group := createDefaultToolsetGroup(tp, false)
+	require.NoError(t, group.EnableToolsets([]string{"all"}))
+	mcpServer := mcp.NewServer(&mcp.Implementation{Name: "test", Version: "v0.0.1"}, nil)
+	group.RegisterTools(mcpServer)
+
+	ctx := context.Background()
+	serverTransport, clientTransport := mcp.NewInMemoryTransports()
+	serverSession, err := mcpServer.Connect(ctx, serverTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, serverSession.Close()) })
+	client := mcp.NewClient(&mcp.Implementation{Name: "test-client", Version: "v0.0.1"}, nil)
+	session, err := client.Connect(ctx, clientTransport, nil)
+	require.NoError(t, err)
+	t.Cleanup(func() { assert.NoError(t, session.Close()) })
+	return session
+}
+
+func TestTypedToolArgumentValidation(t *testing.T) {
+	var requests []string
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		requests = append(requests, req.Method+" "+req.URL.Path)
+		return MockResponse(200, MockTask()), nil
+	})
+	session := connectToolProvider(t, tp)
+	ctx := context.Background()
+
+	tests := []struct {
+		name      string
+		toolName  string
+		arguments map[string]interface{}
+		wantErr   string
+	}{
+		{name: "missing content", toolName: "todoist_create_task", arguments: map[string]interface{}{}, wantErr: `missing properties: ["content"]`},
+		{name: "priority out of range", toolName: "todoist_create_task", arguments: map[string]interface{}{"content": "Task", "priority": 5}, wantErr: "/properties/priority: maximum"},
+		{name: "priority string out of range", toolName: "todoist_update_task", arguments: map[string]interface{}{"id": "1", "priority": "5"}, wantErr: "/properties/priority: pattern"},
+		{name: "priority label out of range", toolName: "todoist_update_task", arguments: map[string]interface{}{"id": "1", "priority": "p5"}, wantErr: "/properties/priority: pattern"},
+		{name: "unknown priority scale", toolName: "todoist_create_task", arguments: map[string]interface{}{"content": "Task", "priority": 1, "priorityScale": "ui"}, wantErr: "/properties/priorityScale: enum"},
+		{name: "subtask without content", toolName: "todoist_create_task", arguments: map[string]interface{}{
+			"content":  "Task",
+			"subtasks": []interface{}{map[string]interface{}{"description": "no content"}},
+		}, wantErr: "/properties/subtasks"},
+		{name: "missing text", toolName: "todoist_quick_add_task", arguments: map[string]interface{}{}, wantErr: `missing properties: ["text"]`},
+		{name: "unknown view", toolName: "todoist_get_tasks", arguments: map[string]interface{}{"view": "outline"}, wantErr: "/properties/view: enum"},
+		{name: "wrong type", toolName: "todoist_update_task", arguments: map[string]interface{}{"id": "1", "labels": "work"}, wantErr: "/properties/labels: type"},
+		{name: "non-numeric order", toolName: "todoist_create_task", arguments: map[string]interface{}{"content": "Task", "order": "first"}, wantErr: "/properties/order: pattern"},
+		{name: "fractional order", toolName: "todoist_create_task", arguments: map[string]interface{}{"content": "Task", "order": 2.5}, wantErr: "/properties/order: type"},
+		{name: "non-numeric duration", toolName: "todoist_update_task", arguments: map[string]interface{}{"id": "1", "duration": "half an hour", "durationUnit": "minute"}, wantErr: "/properties/duration: pattern"},
+		{name: "unknown duration unit", toolName: "todoist_update_task", arguments: map[string]interface{}{"id": "1", "duration": 30, "durationUnit": "hour"}, wantErr: "/properties/durationUnit: enum"},
+		{name: "missing id", toolName: "todoist_reopen_task", arguments: map[string]interface{}{}, wantErr: `missing properties: ["id"]`},
+		{name: "missing project id", toolName: "todoist_delete_project", arguments: map[string]interface{}{}, wantErr: `missing properties: ["id"]`},
+		{name: "unknown view style", toolName: "todoist_create_project", arguments: map[string]interface{}{"name": "Work", "viewStyle": "table"}, wantErr: "/properties/viewStyle: enum"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests = nil
+			result, err := session.CallTool(ctx, &mcp.CallToolParams{Name: tt.toolName, Arguments: tt.arguments})
+			require.NoError(t, err, "invalid arguments are a tool error, not a protocol error")
+			assert.True(t, result.IsError)
+			assert.Contains(t, resultText(result), "Invalid arguments: ")
+			assert.Contains(t, resultText(result), tt.wantErr)
+			assert.Empty(t, requests, "the handler must not run")
+		})
+	}
+
+	// Valid arguments reach the handler, including null to clear a field
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "todoist_update_task",
+		Arguments: map[string]interface{}{"id": "123456789", "description": nil, "priority": "p1"},
+	})
+	require.NoError(t, err)
+	assert.False(t, result.IsError)
+	assert.NotEmpty(t, requests)
+}
+
+func TestTypedToolNumericStrings(t *testing.T) {
+	var bodies []map[string]interface{}
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+		bodies = append(bodies, body)
+		return MockResponse(200, MockTask()), nil
+	})
+	session := connectToolProvider(t, tp)
+	ctx := context.Background()
+
+	// Integers given as strings pass the input schema and are sent as numbers
+	result, err := session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "todoist_create_task",
+		Arguments: map[string]interface{}{"content": "Task", "order": "3"},
+	})
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	require.Len(t, bodies, 1)
+	assert.Equal(t, float64(3), bodies[0]["order"])
+
+	bodies = nil
+	result, err = session.CallTool(ctx, &mcp.CallToolParams{
+		Name:      "todoist_update_task",
+		Arguments: map[string]interface{}{"id": "123456789", "duration": "30", "durationUnit": "minute"},
+	})
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+	require.Len(t, bodies, 1)
+	assert.Equal(t, float64(30), bodies[0]["duration"])
 }
 
 func TestHandleMessage(t *testing.T) {
@@ -76,6 +314,15 @@ func TestHandleToolCall(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "get_completed_tasks",
+			toolName: "todoist_get_completed_tasks",
+			params: map[string]interface{}{
+				"since": "2025-01-01",
+				"until": "2025-01-07",
+			},
+			wantErr: false,
+		},
 		{
 			name:     "create_task",
 			toolName: "todoist_create_task",
@@ -84,6 +331,14 @@ func TestHandleToolCall(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "quick_add_task",
+			toolName: "todoist_quick_add_task",
+			params: map[string]interface{}{
+				"text": "Call mom tomorrow 5pm #Family @phone p1",
+			},
+			wantErr: false,
+		},
 		{
 			name:     "update_task",
 			toolName: "todoist_update_task",
@@ -101,6 +356,14 @@ func TestHandleToolCall(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name:     "reopen_task",
+			toolName: "todoist_reopen_task",
+			params: map[string]interface{}{
+				"id": "123456789",
+			},
+			wantErr: false,
+		},
 		{
 			name:     "delete_task",
 			toolName: "todoist_delete_task",
@@ -0,0 +1,362 @@
+// Package sync maintains a local mirror of a Todoist account using the Sync API.
+//
+// The mirror starts with a full sync and then asks only for changes since the
+// last sync_token, so keeping it current costs a single small request.
+// Resources are stored as the raw JSON objects returned by Todoist; callers
+// decode them into their own models.
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	stdsync "sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Resource is a Todoist resource type mirrored from the Sync API
+type Resource string
+
+const (
+	// Projects are the user's projects
+	Projects Resource = "projects"
+	// Sections are the sections of all projects
+	Sections Resource = "sections"
+	// Labels are the user's personal labels
+	Labels Resource = "labels"
+	// Items are tasks, both active and completed
+	Items Resource = "items"
+	// Notes are comments on tasks
+	Notes Resource = "notes"
+	// ProjectNotes are comments on projects
+	ProjectNotes Resource = "project_notes"
+)
+
+// Resources lists every mirrored resource type
+var Resources = []Resource{Projects, Sections, Labels, Items, Notes, ProjectNotes}
+
+// fullSyncToken requests a full sync
+const fullSyncToken = "*"
+
+// Requester sends a read request to the Todoist Sync endpoint and returns the response body
+type Requester interface {
+	Sync(ctx context.Context, syncToken string, resourceTypes []string) ([]byte, error)
+}
+
+// Mirror is an in-memory copy of the user's Todoist data, kept up to date with
+// incremental syncs. It is safe for concurrent use.
+type Mirror struct {
+	requester Requester
+	logger    *logrus.Logger
+	path      string
+
+	// syncMu serializes syncs, so concurrent callers share one request
+	syncMu stdsync.Mutex
+
+	mu       stdsync.RWMutex
+	state    state
+	lastSync time.Time
+	stale    bool
+}
+
+// state is the mirrored data, as persisted on disk
+type state struct {
+	SyncToken string                      `json:"sync_token"`
+	Resources map[Resource]*resourceStore `json:"resources"`
+}
+
+// resourceStore holds the objects of one resource type in the order they were first seen
+type resourceStore struct {
+	Order   []string                   `json:"order"`
+	Objects map[string]json.RawMessage `json:"objects"`
+}
+
+// Option configures a Mirror
+type Option func(*Mirror)
+
+// WithLogger sets the logger for the mirror
+func WithLogger(logger *logrus.Logger) Option {
+	return func(m *Mirror) {
+		m.logger = logger
+	}
+}
+
+// WithPersistPath stores the mirror in the given file after every sync and loads it on creation,
+// so that a restarted server continues with an incremental sync
+func WithPersistPath(path string) Option {
+	return func(m *Mirror) {
+		m.path = path
+	}
+}
+
+// New creates a mirror that syncs through the requester.
+// If a persist path is set and the file exists, the mirror starts from its contents.
+func New(requester Requester, options ...Option) (*Mirror, error) {
+	m := &Mirror{
+		requester: requester,
+		logger:    logrus.New(),
+		state:     newState(),
+	}
+
+	// Apply options
+	for _, option := range options {
+		option(m)
+	}
+
+	if m.path != "" {
+		if err := m.load(); err != nil {
+			return nil, err
+		}
+	}
+
+	return m, nil
+}
+
+// newState returns an empty state that requires a full sync
+func newState() state {
+	resources := make(map[Resource]*resourceStore, len(Resources))
+	for _, resource := range Resources {
+		resources[resource] = &resourceStore{Objects: map[string]json.RawMessage{}}
+	}
+	return state{SyncToken: fullSyncToken, Resources: resources}
+}
+
+// syncResponse is the part of a Sync API response the mirror uses
+type syncResponse struct {
+	SyncToken string `json:"sync_token"`
+	FullSync  bool   `json:"full_sync"`
+}
+
+// objectHeader holds the fields of a resource object the mirror needs to store it
+type objectHeader struct {
+	ID        string `json:"id"`
+	IsDeleted bool   `json:"is_deleted"`
+}
+
+// Sync fetches the changes since the last sync and applies them to the mirror
+func (m *Mirror) Sync(ctx context.Context) error {
+	m.syncMu.Lock()
+	defer m.syncMu.Unlock()
+
+	return m.sync(ctx)
+}
+
+// Refresh syncs the mirror if its last sync is older than maxAge or it was marked stale.
+// Concurrent callers wait for a single sync.
+func (m *Mirror) Refresh(ctx context.Context, maxAge time.Duration) error {
+	m.syncMu.Lock()
+	defer m.syncMu.Unlock()
+
+	m.mu.RLock()
+	fresh := !m.stale && !m.lastSync.IsZero() && time.Since(m.lastSync) <= maxAge
+	m.mu.RUnlock()
+	if fresh {
+		return nil
+	}
+
+	return m.sync(ctx)
+}
+
+// MarkStale makes the next Refresh sync regardless of the age of the mirror,
+// e.g. after the caller changed data through another API
+func (m *Mirror) MarkStale() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.stale = true
+}
+
+// LastSync returns the time of the last successful sync, or the zero time
+func (m *Mirror) LastSync() time.Time {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	return m.lastSync
+}
+
+// sync performs one sync request; the caller holds syncMu
+func (m *Mirror) sync(ctx context.Context) error {
+	m.mu.RLock()
+	token := m.state.SyncToken
+	m.mu.RUnlock()
+
+	resourceTypes := make([]string, len(Resources))
+	for i, resource := range Resources {
+		resourceTypes[i] = string(resource)
+	}
+
+	body, err := m.requester.Sync(ctx, token, resourceTypes)
+	if err != nil {
+		return fmt.Errorf("failed to sync: %w", err)
+	}
+
+	var resp syncResponse
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return fmt.Errorf("failed to decode sync response: %w", err)
+	}
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(body, &fields); err != nil {
+		return fmt.Errorf("failed to decode sync response: %w", err)
+	}
+	changes := make(map[Resource][]json.RawMessage, len(Resources))
+	for _, resource := range Resources {
+		if raw, ok := fields[string(resource)]; ok {
+			var objects []json.RawMessage
+			if err := json.Unmarshal(raw, &objects); err != nil {
+				return fmt.Errorf("failed to decode %s: %w", resource, err)
+			}
+			changes[resource] = objects
+		}
+	}
+
+	m.mu.Lock()
+	if resp.FullSync {
+		m.state = newState()
+	}
+	count := 0
+	for _, resource := range Resources {
+		for _, object := range changes[resource] {
+			if err := m.state.Resources[resource].apply(object); err != nil {
+				m.mu.Unlock()
+				return fmt.Errorf("failed to apply %s change: %w", resource, err)
+			}
+			count++
+		}
+	}
+	m.state.SyncToken = resp.SyncToken
+	m.lastSync = time.Now()
+	m.stale = false
+	m.mu.Unlock()
+
+	m.logger.WithFields(logrus.Fields{
+		"fullSync": resp.FullSync,
+		"changes":  count,
+	}).Debug("Synced Todoist mirror")
+
+	if m.path != "" {
+		if err := m.save(); err != nil {
+			m.logger.WithError(err).Warn("Failed to persist Todoist mirror")
+		}
+	}
+
+	return nil
+}
+
+// apply stores a changed object, or removes it if it was deleted
+func (rs *resourceStore) apply(object json.RawMessage) error {
+	var header objectHeader
+	if err := json.Unmarshal(object, &header); err != nil {
+		return err
+	}
+	if header.ID == "" {
+		return fmt.Errorf("object has no id")
+	}
+
+	_, exists := rs.Objects[header.ID]
+	if header.IsDeleted {
+		if exists {
+			delete(rs.Objects, header.ID)
+			for i, id := range rs.Order {
+				if id == header.ID {
+					rs.Order = append(rs.Order[:i], rs.Order[i+1:]...)
+					break
+				}
+			}
+		}
+		return nil
+	}
+
+	if !exists {
+		rs.Order = append(rs.Order, header.ID)
+	}
+	rs.Objects[header.ID] = object
+	return nil
+}
+
+// All returns the objects of a resource type in the order they were first synced
+func (m *Mirror) All(resource Resource) []json.RawMessage {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	store, ok := m.state.Resources[resource]
+	if !ok {
+		return nil
+	}
+	objects := make([]json.RawMessage, 0, len(store.Order))
+	for _, id := range store.Order {
+		objects = append(objects, store.Objects[id])
+	}
+	return objects
+}
+
+// Get returns the object of a resource type with the given ID
+func (m *Mirror) Get(resource Resource, id string) (json.RawMessage, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	store, ok := m.state.Resources[resource]
+	if !ok {
+		return nil, false
+	}
+	object, ok := store.Objects[id]
+	return object, ok
+}
+
+// load reads the persisted state, if the file exists
+func (m *Mirror) load() error {
+	data, err := os.ReadFile(m.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to read mirror file: %w", err)
+	}
+
+	loaded := newState()
+	if err := json.Unmarshal(data, &loaded); err != nil {
+		return fmt.Errorf("failed to decode mirror file: %w", err)
+	}
+	for _, resource := range Resources {
+		if loaded.Resources[resource] == nil || loaded.Resources[resource].Objects == nil {
+			loaded.Resources[resource] = &resourceStore{Objects: map[string]json.RawMessage{}}
+		}
+	}
+	if loaded.SyncToken == "" {
+		loaded.SyncToken = fullSyncToken
+	}
+
+	m.state = loaded
+	return nil
+}
+
+// save writes the state to the persist path, replacing the file atomically
+func (m *Mirror) save() error {
+	m.mu.RLock()
+	data, err := json.Marshal(m.state)
+	m.mu.RUnlock()
+	if err != nil {
+		return err
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(m.path), filepath.Base(m.path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+
+	// The temporary file is removed again if it cannot replace the persisted one
+	if _, err := tmp.Write(data); err != nil {
+		return errors.Join(err, tmp.Close(), os.Remove(tmp.Name()))
+	}
+	if err := tmp.Close(); err != nil {
+		return errors.Join(err, os.Remove(tmp.Name()))
+	}
+	if err := os.Rename(tmp.Name(), m.path); err != nil {
+		return errors.Join(err, os.Remove(tmp.Name()))
+	}
+	return nil
+}
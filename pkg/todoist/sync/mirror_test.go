@@ -0,0 +1,177 @@
+package sync
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// fakeRequester returns canned sync responses and records the tokens it was called with
+type fakeRequester struct {
+	responses []string
+	err       error
+	tokens    []string
+}
+
+func (f *fakeRequester) Sync(ctx context.Context, syncToken string, resourceTypes []string) ([]byte, error) {
+	f.tokens = append(f.tokens, syncToken)
+	if f.err != nil {
+		return nil, f.err
+	}
+	if len(f.responses) == 0 {
+		return []byte(`{"sync_token":"` + syncToken + `","full_sync":false}`), nil
+	}
+	resp := f.responses[0]
+	f.responses = f.responses[1:]
+	return []byte(resp), nil
+}
+
+func quietLogger() *logrus.Logger {
+	logger := logrus.New()
+	logger.SetOutput(io.Discard)
+	return logger
+}
+
+func ids(t *testing.T, objects []json.RawMessage) []string {
+	t.Helper()
+	result := []string{}
+	for _, object := range objects {
+		var header objectHeader
+		require.NoError(t, json.Unmarshal(object, &header))
+		result = append(result, header.ID)
+	}
+	return result
+}
+
+func TestMirrorSync(t *testing.T) {
+	requester := &fakeRequester{responses: []string{
+		`{"sync_token":"t1","full_sync":true,
+			"projects":[{"id":"p1","name":"Inbox"},{"id":"p2","name":"Work"}],
+			"items":[{"id":"i1","content":"one"},{"id":"i2","content":"two"}],
+			"labels":[], "user":{"id":"u1"}}`,
+		`{"sync_token":"t2","full_sync":false,
+			"items":[{"id":"i1","content":"one, edited"},{"id":"i2","is_deleted":true},{"id":"i3","content":"three"}]}`,
+	}}
+	mirror, err := New(requester, WithLogger(quietLogger()))
+	require.NoError(t, err)
+	assert.True(t, mirror.LastSync().IsZero())
+
+	// The first sync is a full sync
+	require.NoError(t, mirror.Sync(context.Background()))
+	assert.Equal(t, []string{"p1", "p2"}, ids(t, mirror.All(Projects)))
+	assert.Equal(t, []string{"i1", "i2"}, ids(t, mirror.All(Items)))
+	assert.Empty(t, mirror.All(Notes))
+	assert.False(t, mirror.LastSync().IsZero())
+
+	// The second sync applies updates, deletions and additions
+	require.NoError(t, mirror.Sync(context.Background()))
+	assert.Equal(t, []string{"*", "t1"}, requester.tokens)
+	assert.Equal(t, []string{"i1", "i3"}, ids(t, mirror.All(Items)))
+	assert.Equal(t, []string{"p1", "p2"}, ids(t, mirror.All(Projects)))
+
+	item, ok := mirror.Get(Items, "i1")
+	require.True(t, ok)
+	assert.Contains(t, string(item), "one, edited")
+	_, ok = mirror.Get(Items, "i2")
+	assert.False(t, ok)
+}
+
+func TestMirrorFullSyncReplacesState(t *testing.T) {
+	requester := &fakeRequester{responses: []string{
+		`{"sync_token":"t1","full_sync":true,"projects":[{"id":"p1"},{"id":"p2"}]}`,
+		`{"sync_token":"t2","full_sync":true,"projects":[{"id":"p2"}]}`,
+	}}
+	mirror, err := New(requester, WithLogger(quietLogger()))
+	require.NoError(t, err)
+
+	require.NoError(t, mirror.Sync(context.Background()))
+	require.NoError(t, mirror.Sync(context.Background()))
+	assert.Equal(t, []string{"p2"}, ids(t, mirror.All(Projects)))
+}
+
+func TestMirrorSyncError(t *testing.T) {
+	requester := &fakeRequester{err: errors.New("connection refused")}
+	mirror, err := New(requester, WithLogger(quietLogger()))
+	require.NoError(t, err)
+
+	err = mirror.Refresh(context.Background(), time.Minute)
+	assert.ErrorContains(t, err, "connection refused")
+	assert.True(t, mirror.LastSync().IsZero())
+
+	requester.err = nil
+	requester.responses = []string{`{"sync_token":"t1","full_sync":true,"items":{"not":"a list"}}`}
+	err = mirror.Sync(context.Background())
+	assert.ErrorContains(t, err, "failed to decode items")
+}
+
+func TestMirrorRefresh(t *testing.T) {
+	requester := &fakeRequester{responses: []string{`{"sync_token":"t1","full_sync":true}`}}
+	mirror, err := New(requester, WithLogger(quietLogger()))
+	require.NoError(t, err)
+
+	// A mirror that never synced is always refreshed
+	require.NoError(t, mirror.Refresh(context.Background(), time.Hour))
+	assert.Len(t, requester.tokens, 1)
+
+	// A fresh mirror is not
+	require.NoError(t, mirror.Refresh(context.Background(), time.Hour))
+	assert.Len(t, requester.tokens, 1)
+
+	// A mirror marked stale is
+	mirror.MarkStale()
+	require.NoError(t, mirror.Refresh(context.Background(), time.Hour))
+	assert.Len(t, requester.tokens, 2)
+
+	// So is a mirror older than the maximum age
+	require.NoError(t, mirror.Refresh(context.Background(), 0))
+	assert.Len(t, requester.tokens, 3)
+	assert.Equal(t, []string{"*", "t1", "t1"}, requester.tokens)
+}
+
+func TestMirrorPersistence(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "mirror.json")
+
+	requester := &fakeRequester{responses: []string{
+		`{"sync_token":"t1","full_sync":true,"labels":[{"id":"l1","name":"waiting"}]}`,
+	}}
+	mirror, err := New(requester, WithLogger(quietLogger()), WithPersistPath(path))
+	require.NoError(t, err)
+	require.NoError(t, mirror.Sync(context.Background()))
+
+	// A new mirror continues from the persisted state with an incremental sync
+	requester = &fakeRequester{}
+	restored, err := New(requester, WithLogger(quietLogger()), WithPersistPath(path))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"l1"}, ids(t, restored.All(Labels)))
+
+	require.NoError(t, restored.Refresh(context.Background(), time.Minute))
+	assert.Equal(t, []string{"t1"}, requester.tokens)
+	assert.Equal(t, []string{"l1"}, ids(t, restored.All(Labels)))
+}
+
+func TestMirrorPersistenceFailure(t *testing.T) {
+	dir := t.TempDir()
+	mirror, err := New(&fakeRequester{}, WithLogger(quietLogger()), WithPersistPath(filepath.Join(dir, "mirror.json")))
+	require.NoError(t, err)
+
+	// A directory in place of the persisted file cannot be replaced
+	mirror.path = filepath.Join(dir, "blocked")
+	require.NoError(t, os.Mkdir(mirror.path, 0o700))
+	require.NoError(t, os.WriteFile(filepath.Join(mirror.path, "keep"), nil, 0o600))
+	assert.Error(t, mirror.save())
+
+	// The temporary file is removed again
+	entries, err := os.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, entries, 1)
+	assert.Equal(t, "blocked", entries[0].Name())
+}
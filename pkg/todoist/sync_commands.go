@@ -0,0 +1,127 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// syncCommand is a single write command sent to the Sync API.
+// Some operations, such as reordering sections, are only available as Sync commands.
+type syncCommand struct {
+	Type   string      `json:"type"`
+	UUID   string      `json:"uuid"`
+	TempID string      `json:"temp_id,omitempty"`
+	Args   interface{} `json:"args"`
+}
+
+// syncCommandsResponse is the response to a Sync API commands request
+type syncCommandsResponse struct {
+	SyncStatus    map[string]json.RawMessage `json:"sync_status"`
+	TempIDMapping map[string]string          `json:"temp_id_mapping"`
+}
+
+// syncCommandError is the error status reported for a failed Sync command
+type syncCommandError struct {
+	ErrorCode int    `json:"error_code"`
+	Error     string `json:"error"`
+}
+
+// newSyncCommand creates a Sync command with a fresh UUID
+func newSyncCommand(commandType string, args interface{}) syncCommand {
+	return syncCommand{
+		Type: commandType,
+		UUID: newUUID(),
+		Args: args,
+	}
+}
+
+// executeCommands submits commands to the Sync API in a single request
+func (c *Client) executeCommands(ctx context.Context, commands []syncCommand) (*syncCommandsResponse, error) {
+	endpoint := "/sync"
+
+	// The Sync API takes commands as a form-encoded JSON array
+	commandsJSON, err := json.Marshal(commands)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal commands: %w", err)
+	}
+	form := url.Values{}
+	form.Set("commands", string(commandsJSON))
+
+	resp, err := c.doRequestWithContentType(ctx, "POST", endpoint, strings.NewReader(form.Encode()), "application/x-www-form-urlencoded")
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute commands: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var syncResp syncCommandsResponse
+	if err := json.Unmarshal(bodyBytes, &syncResp); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &syncResp, nil
+}
+
+// ExecuteBatch submits commands to the Sync API in a single request.
+// Todoist applies the commands in order; a failed command does not stop the ones after it.
+func (c *Client) ExecuteBatch(ctx context.Context, commands []BatchCommand) (*BatchResult, error) {
+	syncCommands := make([]syncCommand, len(commands))
+	for i, command := range commands {
+		syncCommands[i] = syncCommand{
+			Type:   command.Type,
+			UUID:   command.UUID,
+			TempID: command.TempID,
+			Args:   command.Args,
+		}
+	}
+
+	syncResp, err := c.executeCommands(ctx, syncCommands)
+	if err != nil {
+		return nil, fmt.Errorf("failed to execute batch: %w", err)
+	}
+
+	result := &BatchResult{
+		Errors:        map[string]error{},
+		TempIDMapping: syncResp.TempIDMapping,
+	}
+	if result.TempIDMapping == nil {
+		result.TempIDMapping = map[string]string{}
+	}
+	for _, command := range commands {
+		if err := commandStatusError(syncResp.SyncStatus[command.UUID]); err != nil {
+			result.Errors[command.UUID] = err
+		}
+	}
+
+	return result, nil
+}
+
+// commandStatusError converts the sync_status entry of a command into an error.
+// It returns nil if the command succeeded.
+func commandStatusError(status json.RawMessage) error {
+	if status == nil {
+		return fmt.Errorf("no status returned for command")
+	}
+
+	var ok string
+	if err := json.Unmarshal(status, &ok); err == nil {
+		if ok == "ok" {
+			return nil
+		}
+		return fmt.Errorf("command failed with status %q", ok)
+	}
+
+	var cmdErr syncCommandError
+	if err := json.Unmarshal(status, &cmdErr); err != nil {
+		return fmt.Errorf("failed to decode command status: %w", err)
+	}
+	return fmt.Errorf("command failed with error code %d: %s", cmdErr.ErrorCode, cmdErr.Error)
+}
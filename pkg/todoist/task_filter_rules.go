@@ -22,11 +22,19 @@ func (tp *ToolProvider) GetTaskFilterRules() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// レスポンス型から出力スキーマを生成
+	outputSchemaJSON, err := outputSchema[FilterRulesResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_get_task_filter_rules",
-		Description: "Get the filter rules and examples for Todoist task filters. Use this information to translate natural language queries into Todoist filter syntax for the todoist_get_tasks tool.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
-		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		Name:         "todoist_get_task_filter_rules",
+		Description:  "Get the filter rules and examples for Todoist task filters. Use this information to translate natural language queries into Todoist filter syntax for the todoist_get_tasks tool.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
 	}
 }
 
@@ -164,5 +172,5 @@ Use comma (,) to display multiple lists in the same view:
 
 Example: "p1 & overdue, p4 & today" - Shows priority 1 overdue tasks and priority 4 tasks due today`
 
-	return newToolResultText(filterRulesText), nil
+	return markdownResult(filterRulesText, FilterRulesResponse{Rules: filterRulesText}), nil
 }
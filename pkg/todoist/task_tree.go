@@ -0,0 +1,335 @@
+package todoist
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+)
+
+// SubtaskParams describes a subtask to create under a new task, with its own subtasks
+type SubtaskParams struct {
+	Content     string          `json:"content" jsonschema:"The content of the subtask (required)."`
+	Description string          `json:"description,omitempty" jsonschema:"Detailed description or notes for the subtask."`
+	Priority    Priority        `json:"priority,omitempty" jsonschema:"Subtask priority, as 'p1' (urgent) to 'p4' (normal) or as an integer read on the priorityScale of the task."`
+	DueString   string          `json:"dueString,omitempty" jsonschema:"Due date in natural language, e.g. 'tomorrow'."`
+	DueDate     string          `json:"dueDate,omitempty" jsonschema:"Due date in YYYY-MM-DD format."`
+	DueDatetime string          `json:"dueDatetime,omitempty" jsonschema:"Due date and time in RFC3339 format."`
+	Subtasks    []SubtaskParams `json:"subtasks,omitempty" jsonschema:"Subtasks of this subtask, nested in the same way."`
+}
+
+// CreatedSubtask is a created subtask together with the subtasks created under it
+type CreatedSubtask struct {
+	Task     TaskView         `json:"task"`
+	Subtasks []CreatedSubtask `json:"subtasks,omitempty"`
+}
+
+// validateSubtasks checks that every subtask in the tree has content
+func validateSubtasks(subtasks []SubtaskParams, path string) error {
+	for i, subtask := range subtasks {
+		subtaskPath := fmt.Sprintf("%s[%d]", path, i)
+		if subtask.Content == "" {
+			return fmt.Errorf("parameter %s.content is required", subtaskPath)
+		}
+		if err := validateSubtasks(subtask.Subtasks, subtaskPath+".subtasks"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// countCreated returns the number of tasks in a tree of created subtasks
+func countCreated(subtasks []CreatedSubtask) int {
+	count := len(subtasks)
+	for _, subtask := range subtasks {
+		count += countCreated(subtask.Subtasks)
+	}
+	return count
+}
+
+// createSubtasks creates the subtasks under parentID depth first, in order.
+// Integer priorities are read on priorityScale. On failure it returns the subtasks created so far
+// together with the error.
+func (tp *ToolProvider) createSubtasks(ctx context.Context, parentID string, subtasks []SubtaskParams, priorityScale string) ([]CreatedSubtask, error) {
+	created := make([]CreatedSubtask, 0, len(subtasks))
+	for _, subtask := range subtasks {
+		task, err := tp.client.CreateTask(ctx, CreateTaskRequest{
+			Content:     subtask.Content,
+			Description: subtask.Description,
+			ParentID:    parentID,
+			Priority:    subtask.Priority.APIValue(priorityScale),
+			DueString:   subtask.DueString,
+			DueDate:     subtask.DueDate,
+			DueDatetime: subtask.DueDatetime,
+		})
+		if err != nil {
+			return created, fmt.Errorf("failed to create subtask %q: %w", subtask.Content, err)
+		}
+
+		node := CreatedSubtask{Task: newTaskView(*task)}
+		children, err := tp.createSubtasks(ctx, task.ID, subtask.Subtasks, priorityScale)
+		node.Subtasks = children
+		created = append(created, node)
+		if err != nil {
+			return created, err
+		}
+	}
+	return created, nil
+}
+
+// rollbackTaskTree deletes a task whose subtask tree could not be created completely.
+// Deleting the task also deletes the subtasks created under it.
+func (tp *ToolProvider) rollbackTaskTree(ctx context.Context, task *Task, created []CreatedSubtask, err error) *mcp.CallToolResult {
+	if deleteErr := tp.client.DeleteTask(ctx, task.ID); deleteErr != nil {
+		tp.logger.WithError(deleteErr).WithField("id", task.ID).Error("Failed to roll back task tree")
+		msg := fmt.Sprintf("Failed to create subtasks, and task %s with %d of its subtasks could not be deleted (%v); delete it manually", task.ID, countCreated(created), deleteErr)
+		return newToolResultAPIError(msg, err, "the parent task", "")
+	}
+
+	tp.logger.WithField("id", task.ID).Info("Rolled back task tree")
+	msg := fmt.Sprintf("Failed to create subtasks; the task and the %d subtasks created so far were deleted", countCreated(created))
+	return newToolResultAPIError(msg, err, "the parent task", "")
+}
+
+// Views of todoist_get_tasks
+const (
+	taskViewList = "list"
+	taskViewTree = "tree"
+)
+
+// GetTasksTreeResponse represents the response from the todoist_get_tasks tool in the tree view
+type GetTasksTreeResponse struct {
+	Projects []ProjectTaskTree `json:"projects"`
+}
+
+// ProjectTaskTree holds the tasks of a project, arranged as in the Todoist app
+type ProjectTaskTree struct {
+	ID       string            `json:"id"`
+	Name     string            `json:"name,omitempty"`
+	Tasks    []TaskNode        `json:"tasks"`
+	Sections []SectionTaskTree `json:"sections,omitempty"`
+}
+
+// SectionTaskTree holds the tasks of a section
+type SectionTaskTree struct {
+	ID    string     `json:"id"`
+	Name  string     `json:"name,omitempty"`
+	Tasks []TaskNode `json:"tasks"`
+}
+
+// TaskNode is a task together with its subtasks
+type TaskNode struct {
+	Task     TaskView   `json:"task"`
+	Subtasks []TaskNode `json:"subtasks,omitempty"`
+}
+
+// taskTree arranges tasks by project and section, looking up their names.
+// If the names cannot be fetched, the tree is returned without them.
+func (tp *ToolProvider) taskTree(ctx context.Context, tasks []Task) []ProjectTaskTree {
+	projects, err := tp.names.getProjects(ctx, tp.client, false)
+	if err != nil {
+		tp.logger.WithError(err).Warn("Failed to get project names for task tree")
+	}
+	sections, err := tp.names.getSections(ctx, tp.client, false)
+	if err != nil {
+		tp.logger.WithError(err).Warn("Failed to get section names for task tree")
+	}
+	return buildTaskTree(tasks, projects, sections)
+}
+
+// buildTaskTree groups tasks by project and section and nests subtasks under their parents.
+// Projects appear in the order of the projects list and sections by their section order.
+// Sibling tasks are sorted by child order, then day order. Tasks whose parent is not among
+// the tasks are placed at the top level.
+func buildTaskTree(tasks []Task, projects []Project, sections []Section) []ProjectTaskTree {
+	projectRank := make(map[string]int, len(projects))
+	for i, project := range projects {
+		projectRank[project.ID] = i
+	}
+	paths := projectPaths(projects)
+	sectionsByID := make(map[string]Section, len(sections))
+	for _, section := range sections {
+		sectionsByID[section.ID] = section
+	}
+
+	present := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		present[task.ID] = true
+	}
+	children := map[string][]Task{}
+	for _, task := range tasks {
+		if task.ParentID != nil && present[*task.ParentID] {
+			children[*task.ParentID] = append(children[*task.ParentID], task)
+		}
+	}
+
+	var buildNodes func(siblings []Task) []TaskNode
+	buildNodes = func(siblings []Task) []TaskNode {
+		sortSiblings(siblings)
+		nodes := make([]TaskNode, len(siblings))
+		for i, task := range siblings {
+			nodes[i] = TaskNode{Task: newTaskView(task), Subtasks: buildNodes(children[task.ID])}
+		}
+		return nodes
+	}
+
+	// Collect the top-level tasks of each project and section
+	var projectIDs []string
+	topLevel := map[string]map[string][]Task{}
+	for _, task := range tasks {
+		if task.ParentID != nil && present[*task.ParentID] {
+			continue
+		}
+		if topLevel[task.ProjectID] == nil {
+			topLevel[task.ProjectID] = map[string][]Task{}
+			projectIDs = append(projectIDs, task.ProjectID)
+		}
+		sectionID := ""
+		if task.SectionID != nil {
+			sectionID = *task.SectionID
+		}
+		topLevel[task.ProjectID][sectionID] = append(topLevel[task.ProjectID][sectionID], task)
+	}
+
+	sort.SliceStable(projectIDs, func(i, j int) bool {
+		rankI, knownI := projectRank[projectIDs[i]]
+		rankJ, knownJ := projectRank[projectIDs[j]]
+		if knownI != knownJ {
+			return knownI
+		}
+		return rankI < rankJ
+	})
+
+	trees := make([]ProjectTaskTree, 0, len(projectIDs))
+	for _, projectID := range projectIDs {
+		tree := ProjectTaskTree{
+			ID:    projectID,
+			Name:  paths[projectID],
+			Tasks: buildNodes(topLevel[projectID][""]),
+		}
+
+		var sectionIDs []string
+		for sectionID := range topLevel[projectID] {
+			if sectionID != "" {
+				sectionIDs = append(sectionIDs, sectionID)
+			}
+		}
+		sort.Slice(sectionIDs, func(i, j int) bool {
+			orderI, orderJ := sectionsByID[sectionIDs[i]].SectionOrder, sectionsByID[sectionIDs[j]].SectionOrder
+			if orderI != orderJ {
+				return orderI < orderJ
+			}
+			return sectionIDs[i] < sectionIDs[j]
+		})
+		for _, sectionID := range sectionIDs {
+			tree.Sections = append(tree.Sections, SectionTaskTree{
+				ID:    sectionID,
+				Name:  sectionsByID[sectionID].Name,
+				Tasks: buildNodes(topLevel[projectID][sectionID]),
+			})
+		}
+
+		trees = append(trees, tree)
+	}
+	return trees
+}
+
+// sortSiblings sorts tasks with the same parent in the order the Todoist app shows them
+func sortSiblings(tasks []Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].ChildOrder != tasks[j].ChildOrder {
+			return tasks[i].ChildOrder < tasks[j].ChildOrder
+		}
+		return tasks[i].DayOrder < tasks[j].DayOrder
+	})
+}
+
+// renderTaskTree renders the tree view of todoist_get_tasks in the requested format.
+// The markdown format comes with the compact tree as structured content.
+func renderTaskTree(format responseFormat, trees []ProjectTaskTree) *mcp.CallToolResult {
+	if format.name == formatFull {
+		return jsonResult(GetTasksTreeResponse{Projects: trees})
+	}
+
+	projects := make([]map[string]interface{}, len(trees))
+	for i, tree := range trees {
+		tasks, err := compactTaskNodes(tree.Tasks, format.fields)
+		if err != nil {
+			return newToolResultError("Failed to marshal response", err)
+		}
+		project := map[string]interface{}{"id": tree.ID, "name": tree.Name, "tasks": tasks}
+
+		sections := make([]map[string]interface{}, len(tree.Sections))
+		for j, section := range tree.Sections {
+			tasks, err := compactTaskNodes(section.Tasks, format.fields)
+			if err != nil {
+				return newToolResultError("Failed to marshal response", err)
+			}
+			sections[j] = map[string]interface{}{"id": section.ID, "name": section.Name, "tasks": tasks}
+		}
+		if len(sections) > 0 {
+			project["sections"] = sections
+		}
+		projects[i] = project
+	}
+	response := map[string]interface{}{"projects": projects}
+
+	if format.name == formatMarkdown {
+		var b strings.Builder
+		if len(trees) == 0 {
+			b.WriteString("No tasks.\n")
+		}
+		for i, tree := range trees {
+			if i > 0 {
+				b.WriteString("\n")
+			}
+			name := tree.Name
+			if name == "" {
+				name = "Project " + tree.ID
+			}
+			fmt.Fprintf(&b, "## %s\n", name)
+			writeTaskNodesMarkdown(&b, tree.Tasks, 0)
+			for _, section := range tree.Sections {
+				name := section.Name
+				if name == "" {
+					name = "Section " + section.ID
+				}
+				fmt.Fprintf(&b, "\n### %s\n", name)
+				writeTaskNodesMarkdown(&b, section.Tasks, 0)
+			}
+		}
+		return markdownResult(b.String(), response)
+	}
+	return jsonResult(response)
+}
+
+// compactTaskNodes reduces the tasks of a tree to the given fields
+func compactTaskNodes(nodes []TaskNode, fields []string) ([]map[string]interface{}, error) {
+	compact := make([]map[string]interface{}, len(nodes))
+	for i, node := range nodes {
+		task, err := pickFields(node.Task, fields)
+		if err != nil {
+			return nil, err
+		}
+		entry := map[string]interface{}{"task": task}
+		if len(node.Subtasks) > 0 {
+			subtasks, err := compactTaskNodes(node.Subtasks, fields)
+			if err != nil {
+				return nil, err
+			}
+			entry["subtasks"] = subtasks
+		}
+		compact[i] = entry
+	}
+	return compact, nil
+}
+
+// writeTaskNodesMarkdown writes tasks as a nested markdown checklist
+func writeTaskNodesMarkdown(b *strings.Builder, nodes []TaskNode, depth int) {
+	for _, node := range nodes {
+		fmt.Fprintf(b, "%s- %s\n", strings.Repeat("  ", depth), taskMarkdown(node.Task))
+		writeTaskNodesMarkdown(b, node.Subtasks, depth+1)
+	}
+}
@@ -0,0 +1,228 @@
+package todoist
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// mockTaskTreeClient answers task creation with sequential IDs and records the requests.
+// Creating a task whose content is failContent fails.
+type mockTaskTreeClient struct {
+	failContent string
+	created     []CreateTaskRequest
+	deleted     []string
+	deleteErr   error
+}
+
+func (m *mockTaskTreeClient) handle(req *http.Request) (*http.Response, error) {
+	switch req.Method {
+	case "POST":
+		var createReq CreateTaskRequest
+		body, _ := io.ReadAll(req.Body)
+		if err := json.Unmarshal(body, &createReq); err != nil {
+			return nil, err
+		}
+		if createReq.Content == m.failContent {
+			return MockResponse(400, ErrorResponse{Error: "Invalid argument value"}), nil
+		}
+		m.created = append(m.created, createReq)
+		task := Task{ID: strconv.Itoa(len(m.created)), Content: createReq.Content}
+		if createReq.ParentID != "" {
+			task.ParentID = strPtr(createReq.ParentID)
+		}
+		return MockResponse(200, task), nil
+	case "DELETE":
+		m.deleted = append(m.deleted, req.URL.Path)
+		if m.deleteErr != nil {
+			return nil, m.deleteErr
+		}
+		return MockResponse(204, nil), nil
+	}
+	return nil, errors.New("unexpected request")
+}
+
+func taskTreeParams() map[string]interface{} {
+	return map[string]interface{}{
+		"content": "Pack for the trip",
+		"subtasks": []interface{}{
+			map[string]interface{}{
+				"content": "Clothes",
+				"subtasks": []interface{}{
+					map[string]interface{}{"content": "Shirts"},
+					map[string]interface{}{"content": "Shoes", "priority": 2},
+				},
+			},
+			map[string]interface{}{"content": "Passport"},
+		},
+	}
+}
+
+func TestHandleCreateTaskWithSubtasks(t *testing.T) {
+	mock := &mockTaskTreeClient{}
+	tp := NewMockToolProviderWithClient(mock.handle)
+
+	result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(taskTreeParams()))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+
+	// The tasks are created depth first, each under its parent
+	require.Len(t, mock.created, 5)
+	assert.Equal(t, "Pack for the trip", mock.created[0].Content)
+	assert.Equal(t, CreateTaskRequest{Content: "Clothes", ParentID: "1"}, mock.created[1])
+	assert.Equal(t, CreateTaskRequest{Content: "Shirts", ParentID: "2"}, mock.created[2])
+	assert.Equal(t, CreateTaskRequest{Content: "Shoes", ParentID: "2", Priority: 2}, mock.created[3])
+	assert.Equal(t, CreateTaskRequest{Content: "Passport", ParentID: "1"}, mock.created[4])
+
+	// The response mirrors the tree
+	var response CreateTaskResponse
+	require.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	assert.Equal(t, "1", response.Task.ID)
+	require.Len(t, response.Subtasks, 2)
+	assert.Equal(t, "Clothes", response.Subtasks[0].Task.Content)
+	require.Len(t, response.Subtasks[0].Subtasks, 2)
+	assert.Equal(t, "4", response.Subtasks[0].Subtasks[1].Task.ID)
+	assert.Equal(t, "Passport", response.Subtasks[1].Task.Content)
+	assert.Empty(t, response.Subtasks[1].Subtasks)
+}
+
+func TestHandleCreateTaskWithSubtasksRollback(t *testing.T) {
+	t.Run("deletes the partial tree", func(t *testing.T) {
+		mock := &mockTaskTreeClient{failContent: "Shoes"}
+		tp := NewMockToolProviderWithClient(mock.handle)
+
+		result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(taskTreeParams()))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, resultText(result), "the task and the 2 subtasks created so far were deleted")
+		assert.Contains(t, resultText(result), `"Shoes"`)
+
+		// Deleting the top-level task removes its subtasks too
+		assert.Len(t, mock.created, 3)
+		assert.Equal(t, []string{"/api/v1/tasks/1"}, mock.deleted)
+	})
+
+	t.Run("reports a failed rollback", func(t *testing.T) {
+		mock := &mockTaskTreeClient{failContent: "Passport", deleteErr: errors.New("connection reset")}
+		tp := NewMockToolProviderWithClient(mock.handle)
+
+		result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(taskTreeParams()))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, resultText(result), "task 1 with 3 of its subtasks could not be deleted")
+		assert.Contains(t, resultText(result), "delete it manually")
+	})
+
+	t.Run("rejects a subtask without content", func(t *testing.T) {
+		mock := &mockTaskTreeClient{}
+		tp := NewMockToolProviderWithClient(mock.handle)
+
+		params := taskTreeParams()
+		params["subtasks"] = []interface{}{map[string]interface{}{"subtasks": []interface{}{}}}
+		result, err := CallTypedTool(context.Background(), tp.HandleCreateTask, MockCallToolRequest(params))
+		require.NoError(t, err)
+		assert.True(t, result.IsError)
+		assert.Contains(t, resultText(result), "subtasks[0].content is required")
+		assert.Empty(t, mock.created)
+	})
+}
+
+func TestBuildTaskTree(t *testing.T) {
+	task := func(id, projectID, sectionID, parentID string, childOrder int) Task {
+		task := Task{ID: id, Content: "Task " + id, ProjectID: projectID, ChildOrder: childOrder}
+		if sectionID != "" {
+			task.SectionID = strPtr(sectionID)
+		}
+		if parentID != "" {
+			task.ParentID = strPtr(parentID)
+		}
+		return task
+	}
+
+	projects := []Project{
+		{ID: "inbox", Name: "Inbox"},
+		{ID: "work", Name: "Work"},
+		{ID: "clients", Name: "Clients", ParentID: strPtr("work")},
+	}
+	sections := []Section{
+		{ID: "later", ProjectID: "work", Name: "Later", SectionOrder: 2},
+		{ID: "next", ProjectID: "work", Name: "Next", SectionOrder: 1},
+	}
+	tasks := []Task{
+		task("1", "clients", "", "", 1),
+		task("2", "work", "later", "", 1),
+		task("3", "work", "next", "", 2),
+		task("4", "work", "next", "", 1),
+		task("5", "work", "next", "3", 2),
+		task("6", "work", "next", "3", 1),
+		task("7", "work", "next", "6", 1),
+		task("8", "work", "", "missing", 1),
+		task("9", "unknown", "", "", 1),
+	}
+
+	tree := buildTaskTree(tasks, projects, sections)
+
+	// Projects follow the projects list; unknown projects come last
+	require.Len(t, tree, 3)
+	assert.Equal(t, "work", tree[0].ID)
+	assert.Equal(t, "Work", tree[0].Name)
+	assert.Equal(t, "Work/Clients", tree[1].Name)
+	assert.Equal(t, "unknown", tree[2].ID)
+	assert.Empty(t, tree[2].Name)
+
+	// A subtask whose parent was not returned is shown at the top level
+	work := tree[0]
+	require.Len(t, work.Tasks, 1)
+	assert.Equal(t, "8", work.Tasks[0].Task.ID)
+
+	// Sections are sorted by section order, tasks by child order, with subtasks nested
+	require.Len(t, work.Sections, 2)
+	assert.Equal(t, "Next", work.Sections[0].Name)
+	assert.Equal(t, "Later", work.Sections[1].Name)
+	next := work.Sections[0].Tasks
+	require.Len(t, next, 2)
+	assert.Equal(t, "4", next[0].Task.ID)
+	assert.Equal(t, "3", next[1].Task.ID)
+	require.Len(t, next[1].Subtasks, 2)
+	assert.Equal(t, "6", next[1].Subtasks[0].Task.ID)
+	assert.Equal(t, "5", next[1].Subtasks[1].Task.ID)
+	require.Len(t, next[1].Subtasks[0].Subtasks, 1)
+	assert.Equal(t, "7", next[1].Subtasks[0].Subtasks[0].Task.ID)
+}
+
+func TestHandleGetTasksTreeView(t *testing.T) {
+	tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+		switch req.URL.Path {
+		case "/api/v1/tasks":
+			return MockResponse(200, MockPaginatedTasks([]Task{
+				{ID: "1", ProjectID: "work", Content: "Parent"},
+				{ID: "2", ProjectID: "work", Content: "Child", ParentID: strPtr("1")},
+			})), nil
+		case "/api/v1/projects":
+			return MockResponse(200, MockPaginatedProjects([]Project{{ID: "work", Name: "Work"}})), nil
+		case "/api/v1/sections":
+			return MockResponse(200, PaginatedResponse[Section]{Results: []Section{}}), nil
+		}
+		return nil, errors.New("unexpected request " + req.URL.Path)
+	})
+
+	result, err := CallTypedTool(context.Background(), tp.HandleGetTasks, MockCallToolRequest(map[string]interface{}{"view": "tree"}))
+	require.NoError(t, err)
+	require.False(t, result.IsError, resultText(result))
+
+	var response GetTasksTreeResponse
+	require.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+	require.Len(t, response.Projects, 1)
+	assert.Equal(t, "Work", response.Projects[0].Name)
+	require.Len(t, response.Projects[0].Tasks, 1)
+	assert.Equal(t, "Parent", response.Projects[0].Tasks[0].Task.Content)
+	require.Len(t, response.Projects[0].Tasks[0].Subtasks, 1)
+	assert.Equal(t, "Child", response.Projects[0].Tasks[0].Subtasks[0].Task.Content)
+}
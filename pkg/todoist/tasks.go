@@ -4,92 +4,147 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // GetTasksParams represents the parameters for the todoist_get_tasks tool
 type GetTasksParams struct {
-	ProjectID string `json:"projectId,omitempty"`
-	Filter    string `json:"filter,omitempty"`
+	ProjectID   string `json:"projectId,omitempty" jsonschema:"Filter tasks by project ID. Retrieves only tasks belonging to the specified project."`
+	ProjectName string `json:"projectName,omitempty" jsonschema:"Filter tasks by project name instead of ID (case-insensitive). Use a path like 'Work/Clients' for sub-projects. Cannot be combined with projectId."`
+	SectionName string `json:"sectionName,omitempty" jsonschema:"Retrieve only tasks in the section with this name (case-insensitive). Searched within the given project, or across all projects if none is given."`
+	Filter      string `json:"filter,omitempty" jsonschema:"Todoist filter query using the Todoist filter syntax. Examples: 'today', 'tomorrow', 'next week', 'overdue', 'priority 1', 'search: meeting', 'date: 2023-12-31', 'no date'. For comprehensive filter rules and examples, use the todoist_get_task_filter_rules tool to get detailed information about available filter syntax."`
+	View        string `json:"view,omitempty" jsonschema:"How to arrange the tasks: 'list' (default) returns a flat list; 'tree' groups the tasks by project and section and nests subtasks under their parents, in the order shown in the Todoist app."`
 }
 
 // GetTasksResponse represents the response from the todoist_get_tasks tool
 type GetTasksResponse struct {
-	Tasks []Task `json:"tasks"`
+	Tasks []TaskView `json:"tasks"`
 }
 
 // GetTaskParams represents the parameters for the todoist_get_task tool
 type GetTaskParams struct {
-	ID string `json:"id"`
+	ID string `json:"id" jsonschema:"The unique identifier of the task to retrieve. Specify the numeric Todoist task ID (e.g., '2995104339')."`
 }
 
 // GetTaskResponse represents the response from the todoist_get_task tool
 type GetTaskResponse struct {
-	Task Task `json:"task"`
+	Task TaskView `json:"task"`
+}
+
+// GetCompletedTasksParams represents the parameters for the todoist_get_completed_tasks tool
+type GetCompletedTasksParams struct {
+	Since     string `json:"since,omitempty" jsonschema:"Start of the completion range, as YYYY-MM-DD or RFC3339 (e.g., '2023-12-01' or '2023-12-01T09:00:00Z'). Defaults to 7 days before until."`
+	Until     string `json:"until,omitempty" jsonschema:"End of the completion range, as YYYY-MM-DD (inclusive) or RFC3339. Defaults to now. The range may not exceed 3 months."`
+	ProjectID string `json:"projectId,omitempty" jsonschema:"Only return tasks completed in the specified project."`
+	Cursor    string `json:"cursor,omitempty" jsonschema:"Cursor returned as nextCursor by a previous call, to fetch the next page of results with the same since, until and projectId."`
+}
+
+// GetCompletedTasksResponse represents the response from the todoist_get_completed_tasks tool
+type GetCompletedTasksResponse struct {
+	Tasks      []TaskView `json:"tasks"`
+	NextCursor string     `json:"nextCursor,omitempty"`
 }
 
 // CreateTaskParams represents the parameters for the todoist_create_task tool
 type CreateTaskParams struct {
-	Content     string `json:"content"`
-	Description string `json:"description,omitempty"`
-	ProjectID   string `json:"projectId,omitempty"`
-	ParentID    string `json:"parentId,omitempty"`
-	Order       int    `json:"order,omitempty"`
-	Priority    int    `json:"priority,omitempty"`
-	DueString   string `json:"dueString,omitempty"`
-	DueDate     string `json:"dueDate,omitempty"`
-	DueDatetime string `json:"dueDatetime,omitempty"`
+	Content       string          `json:"content" jsonschema:"The content of the task (required). Supports text formatting using Markdown syntax. See https://www.todoist.com/help/articles/format-text-in-a-todoist-task-e5dHw9 for formatting options."`
+	Description   string          `json:"description,omitempty" jsonschema:"Detailed description or notes for the task. Supports Markdown formatting for rich text."`
+	ProjectID     string          `json:"projectId,omitempty" jsonschema:"Project ID to assign the task to. If not specified, the task will be added to the Inbox project."`
+	ProjectName   string          `json:"projectName,omitempty" jsonschema:"Project name to assign the task to, instead of projectId (case-insensitive). Use a path like 'Work/Clients' for sub-projects."`
+	SectionID     string          `json:"sectionId,omitempty" jsonschema:"Section ID to place the task in, e.g. a board column. The task is added to the project the section belongs to."`
+	SectionName   string          `json:"sectionName,omitempty" jsonschema:"Section name to place the task in, instead of sectionId (case-insensitive). Searched within the given project, or across all projects if none is given."`
+	ParentID      string          `json:"parentId,omitempty" jsonschema:"Parent task ID for creating subtasks. The task will be created as a child of this task."`
+	Order         Integer         `json:"order,omitempty" jsonschema:"Order value for positioning the task within its parent or project. Tasks are sorted by this value in ascending order."`
+	Priority      Priority        `json:"priority,omitempty" jsonschema:"Task priority, either as shown in the Todoist app from 'p1' (urgent) to 'p4' (normal), or as an integer read on the priorityScale. Prefer the 'p1'-'p4' labels, which cannot be mistaken."`
+	PriorityScale string          `json:"priorityScale,omitempty" jsonschema:"How to read an integer priority: 'api' (default) as in the Todoist API, where 4 is urgent and 1 is normal; 'app' as in the Todoist app, where 1 (p1) is urgent and 4 (p4) is normal. Labels like 'p1' are always read as in the app."`
+	DueString     string          `json:"dueString,omitempty" jsonschema:"Due date in natural language, e.g., 'today', 'tomorrow', 'next Monday', 'Jan 15'. Only one of dueString, dueDate, or dueDatetime should be used."`
+	DueDate       string          `json:"dueDate,omitempty" jsonschema:"Due date in YYYY-MM-DD format, e.g., '2023-12-31'. Only one of dueString, dueDate, or dueDatetime should be used."`
+	DueDatetime   string          `json:"dueDatetime,omitempty" jsonschema:"Due date and time in RFC3339 format, e.g., '2023-12-31T10:00:00Z'. Only one of dueString, dueDate, or dueDatetime should be used."`
+	Subtasks      []SubtaskParams `json:"subtasks,omitempty" jsonschema:"Subtasks to create under the task, in order. Each subtask may have its own subtasks, to any depth. If any of them cannot be created, the task and everything created under it are deleted again."`
 }
 
 // CreateTaskResponse represents the response from the todoist_create_task tool
 type CreateTaskResponse struct {
-	Task Task `json:"task"`
+	Task     TaskView         `json:"task"`
+	Subtasks []CreatedSubtask `json:"subtasks,omitempty"`
 }
 
-// UpdateTaskParams represents the parameters for the todoist_update_task tool
+// QuickAddTaskParams represents the parameters for the todoist_quick_add_task tool
+type QuickAddTaskParams struct {
+	Text         string `json:"text" jsonschema:"The task in Todoist Quick Add syntax (required), e.g., 'Call mom tomorrow 5pm #Family @phone p1'. Supports #Project, /Section, @label, p1-p4 priority, natural-language dates, '{deadline}' and '+assignee'. Project, section and label names are resolved by Todoist."`
+	Note         string `json:"note,omitempty" jsonschema:"A comment to add to the new task."`
+	Reminder     string `json:"reminder,omitempty" jsonschema:"A reminder in natural language, e.g., 'tomorrow 4pm'."`
+	AutoReminder bool   `json:"autoReminder,omitempty" jsonschema:"Add the user's default reminder when the task has a due time."`
+}
+
+// QuickAddTaskResponse represents the response from the todoist_quick_add_task tool
+type QuickAddTaskResponse struct {
+	Task TaskView `json:"task"`
+}
+
+// UpdateTaskParams represents the parameters for the todoist_update_task tool.
+// Nullable fields may be sent as null to clear the corresponding value.
 type UpdateTaskParams struct {
-	ID          string `json:"id"`
-	Content     string `json:"content,omitempty"`
-	Description string `json:"description,omitempty"`
-	Priority    int    `json:"priority,omitempty"`
-	DueString   string `json:"dueString,omitempty"`
-	DueDate     string `json:"dueDate,omitempty"`
-	DueDatetime string `json:"dueDatetime,omitempty"`
+	ID            string             `json:"id" jsonschema:"The unique identifier of the task to update (required). Specify the numeric Todoist task ID (e.g., '2995104339')."`
+	Content       string             `json:"content,omitempty" jsonschema:"The new content of the task. Supports text formatting using Markdown syntax. See https://www.todoist.com/help/articles/format-text-in-a-todoist-task-e5dHw9 for formatting options."`
+	Description   Nullable[string]   `json:"description,omitzero" jsonschema:"Detailed description or notes for the task. Supports Markdown formatting for rich text. Set to an empty string or null to remove the description."`
+	Labels        Nullable[[]string] `json:"labels,omitzero" jsonschema:"Replace all labels of the task with these label names. Pass an empty array to remove all labels."`
+	AddLabels     []string           `json:"addLabels,omitempty" jsonschema:"Label names to add to the task, keeping its existing labels."`
+	RemoveLabels  []string           `json:"removeLabels,omitempty" jsonschema:"Label names to remove from the task, keeping its other labels."`
+	Priority      Priority           `json:"priority,omitempty" jsonschema:"Task priority, either as shown in the Todoist app from 'p1' (urgent) to 'p4' (normal), or as an integer read on the priorityScale. Prefer the 'p1'-'p4' labels, which cannot be mistaken."`
+	PriorityScale string             `json:"priorityScale,omitempty" jsonschema:"How to read an integer priority: 'api' (default) as in the Todoist API, where 4 is urgent and 1 is normal; 'app' as in the Todoist app, where 1 (p1) is urgent and 4 (p4) is normal. Labels like 'p1' are always read as in the app."`
+	DueString     Nullable[string]   `json:"dueString,omitzero" jsonschema:"Due date in natural language, e.g., 'today', 'tomorrow', 'next Monday', 'Jan 15'. Set to 'no date' or null to remove the due date. Only one of dueString, dueDate, or dueDatetime may be given."`
+	DueDate       Nullable[string]   `json:"dueDate,omitzero" jsonschema:"Due date in YYYY-MM-DD format, e.g., '2023-12-31'. Set to null to remove the due date. Only one of dueString, dueDate, or dueDatetime may be given."`
+	DueDatetime   Nullable[string]   `json:"dueDatetime,omitzero" jsonschema:"Due date and time in RFC3339 format, e.g., '2023-12-31T10:00:00Z'. Set to null to remove the due date. Only one of dueString, dueDate, or dueDatetime may be given."`
+	AssigneeID    Nullable[string]   `json:"assigneeId,omitzero" jsonschema:"User ID of the collaborator responsible for the task (shared projects only). Set to null to unassign the task."`
+	Duration      Nullable[Integer]  `json:"duration,omitzero" jsonschema:"Amount of time the task will take, in durationUnit units. Requires durationUnit. Set to null to remove the duration."`
+	DurationUnit  string             `json:"durationUnit,omitempty" jsonschema:"Unit of the duration."`
+	DeadlineDate  Nullable[string]   `json:"deadlineDate,omitzero" jsonschema:"Deadline in YYYY-MM-DD format, e.g., '2023-12-31'. Set to null to remove the deadline."`
+	ProjectID     string             `json:"projectId,omitempty" jsonschema:"Move the task to the root of this project. Only one of projectId, sectionId, or parentId should be used."`
+	SectionID     string             `json:"sectionId,omitempty" jsonschema:"Move the task into this section. Only one of projectId, sectionId, or parentId should be used."`
+	ParentID      string             `json:"parentId,omitempty" jsonschema:"Move the task under this parent task, making it a subtask. Only one of projectId, sectionId, or parentId should be used."`
 }
 
 // UpdateTaskResponse represents the response from the todoist_update_task tool
 type UpdateTaskResponse struct {
-	Task Task `json:"task"`
+	Task TaskView `json:"task"`
 }
 
 // CloseTaskParams represents the parameters for the todoist_close_task tool
 type CloseTaskParams struct {
-	ID string `json:"id"`
+	ID string `json:"id" jsonschema:"The unique identifier of the task to mark as completed (required). Specify the numeric Todoist task ID (e.g., '2995104339')."`
+}
+
+// ReopenTaskParams represents the parameters for the todoist_reopen_task tool
+type ReopenTaskParams struct {
+	ID string `json:"id" jsonschema:"The unique identifier of the completed task to reopen (required). Specify the numeric Todoist task ID (e.g., '2995104339'). Use this to undo todoist_close_task."`
+}
+
+// ReopenTaskResponse represents the response from the todoist_reopen_task tool
+type ReopenTaskResponse struct {
+	Task TaskView `json:"task"`
 }
 
 // DeleteTaskParams represents the parameters for the todoist_delete_task tool
 type DeleteTaskParams struct {
-	ID string `json:"id"`
+	ID string `json:"id" jsonschema:"The unique identifier of the task to delete (required). Specify the numeric Todoist task ID (e.g., '2995104339'). Warning: This action is permanent and cannot be undone."`
 }
 
 // GetTasks returns the todoist_get_tasks tool
 func (tp *ToolProvider) GetTasks() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type": "object",
-		"properties": map[string]interface{}{
-			"projectId": map[string]interface{}{
-				"type":        "string",
-				"description": "Filter tasks by project ID. Retrieves only tasks belonging to the specified project.",
-			},
-			"filter": map[string]interface{}{
-				"type":        "string",
-				"description": "Todoist filter query using the Todoist filter syntax. Examples: 'today', 'tomorrow', 'next week', 'overdue', 'priority 1', 'search: meeting', 'date: 2023-12-31', 'no date'. For comprehensive filter rules and examples, use the todoist_get_task_filter_rules tool to get detailed information about available filter syntax.",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[GetTasksParams](map[string]map[string]interface{}{
+		"view": {"enum": []string{taskViewList, taskViewTree}},
+	})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
+	addFormatProperties(inputSchema, taskRenderer)
+
 	// Convert the input schema to JSON
 	inputSchemaJSON, err := json.Marshal(inputSchema)
 	if err != nil {
@@ -97,23 +152,43 @@ func (tp *ToolProvider) GetTasks() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[getTasksOutput]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_get_tasks",
-		Description: "Get a list of tasks.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
-		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		Name:         "todoist_get_tasks",
+		Description:  "Get a list of tasks.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
 	}
 }
 
 // HandleGetTasks handles the todoist_get_tasks tool request
-func (tp *ToolProvider) HandleGetTasks(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (tp *ToolProvider) HandleGetTasks(ctx context.Context, request *mcp.CallToolRequest, params GetTasksParams) (*mcp.CallToolResult, error) {
 	// Parse parameters
-	projectID, _ := OptionalParam[string](request, "projectId")
-	filter, _ := OptionalParam[string](request, "filter")
+	format, err := parseFormat(request, taskRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	filter := params.Filter
+
+	// Resolve project and section names
+	projectID, sectionID, err := tp.resolveLocation(ctx, params.ProjectID, params.ProjectName, "", params.SectionName)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to resolve project or section name")
+		return newToolResultError("Failed to resolve project or section name", err), nil
+	}
 
 	// Log the request
 	tp.logger.WithFields(map[string]interface{}{
 		"projectId": projectID,
+		"sectionId": sectionID,
 		"filter":    filter,
 	}).Info("Getting tasks")
 
@@ -121,36 +196,45 @@ func (tp *ToolProvider) HandleGetTasks(ctx context.Context, request *mcp.CallToo
 	tasks, err := tp.client.GetTasks(ctx, projectID, filter)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to get tasks")
-		return newToolResultError("Failed to get tasks", err), nil
+		return newToolResultAPIError("Failed to get tasks", err, namedResource("project", projectID), projectID), nil
 	}
 
-	// Convert tasks to JSON
-	response := GetTasksResponse{
-		Tasks: tasks,
+	// Keep only the tasks in the requested section
+	if sectionID != "" {
+		inSection := []Task{}
+		for _, task := range tasks {
+			if task.SectionID != nil && *task.SectionID == sectionID {
+				inSection = append(inSection, task)
+			}
+		}
+		tasks = inSection
 	}
-	responseJSON, err := json.Marshal(response)
-	if err != nil {
-		return newToolResultError("Failed to marshal response", err), nil
+
+	if params.View == taskViewTree {
+		return renderTaskTree(format, tp.taskTree(ctx, tasks)), nil
 	}
 
-	// Return the response
-	return newToolResultText(string(responseJSON)), nil
+	// Build the response
+	views := newTaskViews(tasks)
+	response := GetTasksResponse{
+		Tasks: views,
+	}
+
+	// Return the response in the requested format
+	return renderList(format, taskRenderer, response, "tasks", views, nil), nil
 }
 
 // GetTask returns the todoist_get_task tool
 func (tp *ToolProvider) GetTask() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":     "object",
-		"required": []string{"id"},
-		"properties": map[string]interface{}{
-			"id": map[string]interface{}{
-				"type":        "string",
-				"description": "The unique identifier of the task to retrieve. Specify the numeric Todoist task ID (e.g., '2995104339').",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[GetTaskParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
+	addFormatProperties(inputSchema, taskRenderer)
+
 	// Convert the input schema to JSON
 	inputSchemaJSON, err := json.Marshal(inputSchema)
 	if err != nil {
@@ -158,22 +242,32 @@ func (tp *ToolProvider) GetTask() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetTaskResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_get_task",
-		Description: "Get a specific task by ID.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
-		Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true},
+		Name:         "todoist_get_task",
+		Description:  "Get a specific task by ID.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
 	}
 }
 
 // HandleGetTask handles the todoist_get_task tool request
-func (tp *ToolProvider) HandleGetTask(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (tp *ToolProvider) HandleGetTask(ctx context.Context, request *mcp.CallToolRequest, params GetTaskParams) (*mcp.CallToolResult, error) {
 	// Parse parameters
-	id, err := RequiredParam[string](request, "id")
+	format, err := parseFormat(request, taskRenderer)
 	if err != nil {
-		return newToolResultError("Missing required parameter: id", err), nil
+		return newToolResultError("Invalid format parameters", err), nil
 	}
 
+	id := params.ID
+
 	// Log the request
 	tp.logger.WithFields(map[string]interface{}{
 		"id": id,
@@ -183,68 +277,154 @@ func (tp *ToolProvider) HandleGetTask(ctx context.Context, request *mcp.CallTool
 	task, err := tp.client.GetTask(ctx, id)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to get task")
-		return newToolResultError("Failed to get task", err), nil
+		return newToolResultAPIError("Failed to get task", err, "task", id), nil
 	}
 
 	// Convert task to JSON
 	response := GetTaskResponse{
-		Task: *task,
+		Task: newTaskView(*task),
 	}
-	responseJSON, err := json.Marshal(response)
+
+	// Return the response in the requested format
+	return renderObject(format, taskRenderer, response, "task", response.Task), nil
+}
+
+// completedTasksDefaultRange is the range searched when no since date is given
+const completedTasksDefaultRange = 7 * 24 * time.Hour
+
+// completedTasksMaxRange is the longest range the completed tasks endpoint accepts
+const completedTasksMaxRange = 92 * 24 * time.Hour
+
+// GetCompletedTasks returns the todoist_get_completed_tasks tool
+func (tp *ToolProvider) GetCompletedTasks() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[GetCompletedTasksParams](nil)
 	if err != nil {
-		return newToolResultError("Failed to marshal response", err), nil
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
-	// Return the response
-	return newToolResultText(string(responseJSON)), nil
+	addFormatProperties(inputSchema, taskRenderer)
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[GetCompletedTasksResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_get_completed_tasks",
+		Description:  "Get tasks completed within a date range, with their completion time. Returns nextCursor when more results are available.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+		Annotations:  &mcp.ToolAnnotations{ReadOnlyHint: true},
+	}
+}
+
+// HandleGetCompletedTasks handles the todoist_get_completed_tasks tool request
+func (tp *ToolProvider) HandleGetCompletedTasks(ctx context.Context, request *mcp.CallToolRequest, params GetCompletedTasksParams) (*mcp.CallToolResult, error) {
+	// Parse parameters
+	format, err := parseFormat(request, taskRenderer)
+	if err != nil {
+		return newToolResultError("Invalid format parameters", err), nil
+	}
+
+	projectID := params.ProjectID
+	cursor := params.Cursor
+
+	until := time.Now()
+	if params.Until != "" {
+		var err error
+		until, err = parseTimeParam(params.Until, true)
+		if err != nil {
+			return newToolResultError("Invalid parameter: until", err), nil
+		}
+	}
+
+	since := until.Add(-completedTasksDefaultRange)
+	if params.Since != "" {
+		var err error
+		since, err = parseTimeParam(params.Since, false)
+		if err != nil {
+			return newToolResultError("Invalid parameter: since", err), nil
+		}
+	}
+
+	if !since.Before(until) {
+		return newToolResultError("Invalid parameters", fmt.Errorf("since must be before until")), nil
+	}
+	if until.Sub(since) > completedTasksMaxRange {
+		return newToolResultError("Invalid parameters", fmt.Errorf("the range between since and until may not exceed 3 months")), nil
+	}
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"since":     since,
+		"until":     until,
+		"projectId": projectID,
+		"cursor":    cursor,
+	}).Info("Getting completed tasks")
+
+	// Call the Todoist API
+	page, err := tp.client.GetCompletedTasks(ctx, CompletedTasksRequest{
+		Since:     since,
+		Until:     until,
+		ProjectID: projectID,
+		Cursor:    cursor,
+	})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get completed tasks")
+		return newToolResultAPIError("Failed to get completed tasks", err, namedResource("project", projectID), projectID), nil
+	}
+
+	// Convert tasks to JSON
+	response := GetCompletedTasksResponse{
+		Tasks: newTaskViews(page.Items),
+	}
+	extra := map[string]interface{}{}
+	if page.NextCursor != nil {
+		response.NextCursor = *page.NextCursor
+		extra["nextCursor"] = *page.NextCursor
+	}
+
+	// Return the response in the requested format
+	return renderList(format, taskRenderer, response, "tasks", response.Tasks, extra), nil
+}
+
+// parseTimeParam parses a YYYY-MM-DD or RFC3339 time parameter.
+// A date without a time is the start of that day in UTC, or its last second if endOfDay is set.
+func parseTimeParam(value string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, value); err == nil {
+		return t, nil
+	}
+
+	t, err := time.Parse(time.DateOnly, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%q is not a YYYY-MM-DD date or RFC3339 time", value)
+	}
+	if endOfDay {
+		t = t.Add(24*time.Hour - time.Second)
+	}
+	return t, nil
 }
 
 // CreateTask returns the todoist_create_task tool
 func (tp *ToolProvider) CreateTask() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":     "object",
-		"required": []string{"content"},
-		"properties": map[string]interface{}{
-			"content": map[string]interface{}{
-				"type":        "string",
-				"description": "The content of the task (required). Supports text formatting using Markdown syntax. See https://www.todoist.com/help/articles/format-text-in-a-todoist-task-e5dHw9 for formatting options.",
-			},
-			"description": map[string]interface{}{
-				"type":        "string",
-				"description": "Detailed description or notes for the task. Supports Markdown formatting for rich text.",
-			},
-			"projectId": map[string]interface{}{
-				"type":        "string",
-				"description": "Project ID to assign the task to. If not specified, the task will be added to the Inbox project.",
-			},
-			"parentId": map[string]interface{}{
-				"type":        "string",
-				"description": "Parent task ID for creating subtasks. The task will be created as a child of this task.",
-			},
-			"order": map[string]interface{}{
-				"type":        "integer",
-				"description": "Order value for positioning the task within its parent or project. Tasks are sorted by this value in ascending order.",
-			},
-			"priority": map[string]interface{}{
-				"type":        "integer",
-				"description": "Task priority: 1 (normal, default), 2 (medium), 3 (high), 4 (urgent). Note that 4 is the highest priority, 1 is the lowest.",
-				"minimum":     1,
-				"maximum":     4,
-			},
-			"dueString": map[string]interface{}{
-				"type":        "string",
-				"description": "Due date in natural language, e.g., 'today', 'tomorrow', 'next Monday', 'Jan 15'. Only one of dueString, dueDate, or dueDatetime should be used.",
-			},
-			"dueDate": map[string]interface{}{
-				"type":        "string",
-				"description": "Due date in YYYY-MM-DD format, e.g., '2023-12-31'. Only one of dueString, dueDate, or dueDatetime should be used.",
-			},
-			"dueDatetime": map[string]interface{}{
-				"type":        "string",
-				"description": "Due date and time in RFC3339 format, e.g., '2023-12-31T10:00:00Z'. Only one of dueString, dueDate, or dueDatetime should be used.",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[CreateTaskParams](map[string]map[string]interface{}{
+		"priorityScale": {"enum": []string{priorityScaleAPI, priorityScaleApp}},
+	})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
 	// Convert the input schema to JSON
@@ -254,110 +434,158 @@ func (tp *ToolProvider) CreateTask() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[CreateTaskResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_create_task",
-		Description: "Create a new task.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
+		Name:         "todoist_create_task",
+		Description:  "Create a new task, optionally with a tree of subtasks such as a checklist.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
 	}
 }
 
 // HandleCreateTask handles the todoist_create_task tool request
-func (tp *ToolProvider) HandleCreateTask(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (tp *ToolProvider) HandleCreateTask(ctx context.Context, request *mcp.CallToolRequest, params CreateTaskParams) (*mcp.CallToolResult, error) {
 	// Parse parameters
-	content, err := RequiredParam[string](request, "content")
-	if err != nil {
-		return newToolResultError("Missing required parameter: content", err), nil
+	if err := validateSubtasks(params.Subtasks, "subtasks"); err != nil {
+		return newToolResultError("Invalid parameter: subtasks", err), nil
 	}
 
-	description, _ := OptionalParam[string](request, "description")
-	projectID, _ := OptionalParam[string](request, "projectId")
-	parentID, _ := OptionalParam[string](request, "parentId")
-	order, _ := OptionalParam[int](request, "order")
-	priority, _ := OptionalParam[int](request, "priority")
-	dueString, _ := OptionalParam[string](request, "dueString")
-	dueDate, _ := OptionalParam[string](request, "dueDate")
-	dueDatetime, _ := OptionalParam[string](request, "dueDatetime")
+	// Resolve project and section names
+	projectID, sectionID, err := tp.resolveLocation(ctx, params.ProjectID, params.ProjectName, params.SectionID, params.SectionName)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to resolve project or section name")
+		return newToolResultError("Failed to resolve project or section name", err), nil
+	}
 
 	// Log the request
 	tp.logger.WithFields(map[string]interface{}{
-		"content":     content,
-		"description": description,
+		"content":     params.Content,
+		"description": params.Description,
 		"projectId":   projectID,
-		"parentId":    parentID,
-		"priority":    priority,
+		"sectionId":   sectionID,
+		"parentId":    params.ParentID,
+		"priority":    params.Priority.APIValue(params.PriorityScale),
+		"subtasks":    len(params.Subtasks),
 	}).Info("Creating task")
 
 	// Create request
 	createReq := CreateTaskRequest{
-		Content:     content,
-		Description: description,
+		Content:     params.Content,
+		Description: params.Description,
 		ProjectID:   projectID,
-		ParentID:    parentID,
-		Order:       order,
-		Priority:    priority,
-		DueString:   dueString,
-		DueDate:     dueDate,
-		DueDatetime: dueDatetime,
+		SectionID:   sectionID,
+		ParentID:    params.ParentID,
+		Order:       int(params.Order),
+		Priority:    params.Priority.APIValue(params.PriorityScale),
+		DueString:   params.DueString,
+		DueDate:     params.DueDate,
+		DueDatetime: params.DueDatetime,
 	}
 
 	// Call the Todoist API
 	task, err := tp.client.CreateTask(ctx, createReq)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to create task")
-		return newToolResultError("Failed to create task", err), nil
+		// Only a location given by the request can be missing
+		resource := ""
+		if projectID != "" || sectionID != "" || params.ParentID != "" {
+			resource = "the project, section or parent task"
+		}
+		return newToolResultAPIError("Failed to create task", err, resource, ""), nil
+	}
+
+	// Create the subtask tree under the new task
+	subtasks, err := tp.createSubtasks(ctx, task.ID, params.Subtasks, params.PriorityScale)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to create subtasks")
+		return tp.rollbackTaskTree(ctx, task, subtasks, err), nil
 	}
 
 	// Convert task to JSON
 	response := CreateTaskResponse{
-		Task: *task,
+		Task:     newTaskView(*task),
+		Subtasks: subtasks,
+	}
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// QuickAddTask returns the todoist_quick_add_task tool
+func (tp *ToolProvider) QuickAddTask() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[QuickAddTaskParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[QuickAddTaskResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_quick_add_task",
+		Description:  "Create a new task from a natural-language string, as in the Todoist Quick Add box. Project, label, priority and date syntax are parsed by Todoist.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
 	}
-	responseJSON, err := json.Marshal(response)
+}
+
+// HandleQuickAddTask handles the todoist_quick_add_task tool request
+func (tp *ToolProvider) HandleQuickAddTask(ctx context.Context, request *mcp.CallToolRequest, params QuickAddTaskParams) (*mcp.CallToolResult, error) {
+	// Log the request
+	tp.logger.WithField("text", params.Text).Info("Quick adding task")
+
+	// Create request
+	quickAddReq := QuickAddTaskRequest{
+		Text:         params.Text,
+		Note:         params.Note,
+		Reminder:     params.Reminder,
+		AutoReminder: params.AutoReminder,
+	}
+
+	// Call the Todoist API
+	task, err := tp.client.QuickAddTask(ctx, quickAddReq)
 	if err != nil {
-		return newToolResultError("Failed to marshal response", err), nil
+		tp.logger.WithError(err).Error("Failed to quick add task")
+		return newToolResultAPIError("Failed to quick add task", err, "", ""), nil
 	}
 
+	// Convert task to JSON
+	response := QuickAddTaskResponse{
+		Task: newTaskView(*task),
+	}
 	// Return the response
-	return newToolResultText(string(responseJSON)), nil
+	return jsonResult(response), nil
 }
 
 // UpdateTask returns the todoist_update_task tool
 func (tp *ToolProvider) UpdateTask() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":     "object",
-		"required": []string{"id"},
-		"properties": map[string]interface{}{
-			"id": map[string]interface{}{
-				"type":        "string",
-				"description": "The unique identifier of the task to update (required). Specify the numeric Todoist task ID (e.g., '2995104339').",
-			},
-			"content": map[string]interface{}{
-				"type":        "string",
-				"description": "The new content of the task. Supports text formatting using Markdown syntax. See https://www.todoist.com/help/articles/format-text-in-a-todoist-task-e5dHw9 for formatting options.",
-			},
-			"description": map[string]interface{}{
-				"type":        "string",
-				"description": "Detailed description or notes for the task. Supports Markdown formatting for rich text.",
-			},
-			"priority": map[string]interface{}{
-				"type":        "integer",
-				"description": "Task priority: 1 (normal, default), 2 (medium), 3 (high), 4 (urgent). Note that 4 is the highest priority, 1 is the lowest.",
-				"minimum":     1,
-				"maximum":     4,
-			},
-			"dueString": map[string]interface{}{
-				"type":        "string",
-				"description": "Due date in natural language, e.g., 'today', 'tomorrow', 'next Monday', 'Jan 15'. Only one of dueString, dueDate, or dueDatetime should be used.",
-			},
-			"dueDate": map[string]interface{}{
-				"type":        "string",
-				"description": "Due date in YYYY-MM-DD format, e.g., '2023-12-31'. Only one of dueString, dueDate, or dueDatetime should be used.",
-			},
-			"dueDatetime": map[string]interface{}{
-				"type":        "string",
-				"description": "Due date and time in RFC3339 format, e.g., '2023-12-31T10:00:00Z'. Only one of dueString, dueDate, or dueDatetime should be used.",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[UpdateTaskParams](map[string]map[string]interface{}{
+		"duration":      {"minimum": 1},
+		"durationUnit":  {"enum": []string{"minute", "day"}},
+		"priorityScale": {"enum": []string{priorityScaleAPI, priorityScaleApp}},
+	})
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
 	// Convert the input schema to JSON
@@ -367,78 +595,205 @@ func (tp *ToolProvider) UpdateTask() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[UpdateTaskResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_update_task",
-		Description: "Update an existing task.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
+		Name:         "todoist_update_task",
+		Description:  "Update an existing task, including moving it to another project, section or parent task.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
 	}
 }
 
 // HandleUpdateTask handles the todoist_update_task tool request
-func (tp *ToolProvider) HandleUpdateTask(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+func (tp *ToolProvider) HandleUpdateTask(ctx context.Context, request *mcp.CallToolRequest, params UpdateTaskParams) (*mcp.CallToolResult, error) {
 	// Parse parameters
-	id, err := RequiredParam[string](request, "id")
+	id := params.ID
+	updateReq, moveReq, labelChanges, err := parseUpdateTaskParams(params)
 	if err != nil {
-		return newToolResultError("Missing required parameter: id", err), nil
+		return newToolResultError("Invalid parameters", err), nil
 	}
 
-	content, _ := OptionalParam[string](request, "content")
-	description, _ := OptionalParam[string](request, "description")
-	priority, _ := OptionalParam[int](request, "priority")
-	dueString, _ := OptionalParam[string](request, "dueString")
-	dueDate, _ := OptionalParam[string](request, "dueDate")
-	dueDatetime, _ := OptionalParam[string](request, "dueDatetime")
-
 	// Log the request
 	tp.logger.WithFields(map[string]interface{}{
-		"id":          id,
-		"content":     content,
-		"description": description,
-		"priority":    priority,
+		"id":           id,
+		"content":      updateReq.Content,
+		"priority":     updateReq.Priority,
+		"addLabels":    labelChanges.add,
+		"removeLabels": labelChanges.remove,
+		"projectId":    moveReq.ProjectID,
+		"sectionId":    moveReq.SectionID,
+		"parentId":     moveReq.ParentID,
 	}).Info("Updating task")
 
-	// Create request
-	updateReq := UpdateTaskRequest{
-		Content:     content,
-		Description: description,
-		Priority:    priority,
-		DueString:   dueString,
-		DueDate:     dueDate,
-		DueDatetime: dueDatetime,
+	// Adding or removing individual labels requires the task's current labels
+	if updateReq.Labels == nil && labelChanges.requested() {
+		current, err := tp.client.GetTask(ctx, id)
+		if err != nil {
+			tp.logger.WithError(err).Error("Failed to get task labels")
+			return newToolResultAPIError("Failed to get task labels", err, "task", id), nil
+		}
+		labels := labelChanges.apply(current.Labels)
+		updateReq.Labels = &labels
+	} else if updateReq.Labels != nil {
+		labels := labelChanges.apply(*updateReq.Labels)
+		updateReq.Labels = &labels
 	}
 
 	// Call the Todoist API
-	task, err := tp.client.UpdateTask(ctx, id, updateReq)
-	if err != nil {
-		tp.logger.WithError(err).Error("Failed to update task")
-		return newToolResultError("Failed to update task", err), nil
+	var task *Task
+	hasMove := moveReq != (MoveTaskRequest{})
+	if updateReq != (UpdateTaskRequest{}) || !hasMove {
+		task, err = tp.client.UpdateTask(ctx, id, updateReq)
+		if err != nil {
+			tp.logger.WithError(err).Error("Failed to update task")
+			return newToolResultAPIError("Failed to update task", err, "task", id), nil
+		}
+	}
+
+	if hasMove {
+		task, err = tp.client.MoveTask(ctx, id, moveReq)
+		if err != nil {
+			tp.logger.WithError(err).Error("Failed to move task")
+			return newToolResultAPIError("Failed to move task", err, "task or its destination", id), nil
+		}
 	}
 
 	// Convert task to JSON
 	response := UpdateTaskResponse{
-		Task: *task,
+		Task: newTaskView(*task),
 	}
-	responseJSON, err := json.Marshal(response)
-	if err != nil {
-		return newToolResultError("Failed to marshal response", err), nil
+	// Return the response
+	return jsonResult(response), nil
+}
+
+// labelChanges holds labels to add to and remove from a task's existing labels
+type labelChanges struct {
+	add    []string
+	remove []string
+}
+
+// requested reports whether any label is added or removed
+func (lc labelChanges) requested() bool {
+	return len(lc.add) > 0 || len(lc.remove) > 0
+}
+
+// apply returns labels with the additions and removals applied, preserving order
+func (lc labelChanges) apply(labels []string) []string {
+	removed := make(map[string]bool, len(lc.remove))
+	for _, label := range lc.remove {
+		removed[label] = true
 	}
 
-	// Return the response
-	return newToolResultText(string(responseJSON)), nil
+	result := []string{}
+	seen := make(map[string]bool)
+	for _, label := range append(append([]string{}, labels...), lc.add...) {
+		if removed[label] || seen[label] {
+			continue
+		}
+		seen[label] = true
+		result = append(result, label)
+	}
+	return result
+}
+
+// parseUpdateTaskParams converts the todoist_update_task parameters into the update and move
+// requests sent to the Todoist API
+func parseUpdateTaskParams(params UpdateTaskParams) (UpdateTaskRequest, MoveTaskRequest, labelChanges, error) {
+	updateReq := UpdateTaskRequest{
+		Content:      params.Content,
+		Priority:     params.Priority.APIValue(params.PriorityScale),
+		AssigneeID:   params.AssigneeID,
+		DeadlineDate: params.DeadlineDate,
+	}
+	moveReq := MoveTaskRequest{
+		ProjectID: params.ProjectID,
+		SectionID: params.SectionID,
+		ParentID:  params.ParentID,
+	}
+	changes := labelChanges{add: params.AddLabels, remove: params.RemoveLabels}
+
+	// An empty or null description clears it
+	if !params.Description.IsZero() {
+		description, _ := params.Description.Value()
+		updateReq.Description = &description
+	}
+
+	// Labels; null removes all of them like an empty array
+	if !params.Labels.IsZero() {
+		labels, _ := params.Labels.Value()
+		if labels == nil {
+			labels = []string{}
+		}
+		updateReq.Labels = &labels
+	}
+
+	// Due date; only one due field may be given, and a null value in it clears the due date
+	dueFields := 0
+	for _, due := range []struct {
+		param Nullable[string]
+		field *string
+	}{
+		{params.DueString, &updateReq.DueString},
+		{params.DueDate, &updateReq.DueDate},
+		{params.DueDatetime, &updateReq.DueDatetime},
+	} {
+		if due.param.IsZero() {
+			continue
+		}
+		dueFields++
+		if due.param.IsNull() {
+			updateReq.DueString = NoDueDate
+			continue
+		}
+		*due.field, _ = due.param.Value()
+	}
+	if dueFields > 1 {
+		return updateReq, moveReq, changes, fmt.Errorf("only one of dueString, dueDate, or dueDatetime may be specified")
+	}
+
+	// Duration must be given together with its unit
+	switch {
+	case params.Duration.IsNull():
+		updateReq.Duration = NullValue[int]()
+		updateReq.DurationUnit = NullValue[string]()
+	case !params.Duration.IsZero():
+		if params.DurationUnit == "" {
+			return updateReq, moveReq, changes, fmt.Errorf("durationUnit is required when duration is set")
+		}
+		duration, _ := params.Duration.Value()
+		updateReq.Duration = NewNullable(int(duration))
+		updateReq.DurationUnit = NewNullable(params.DurationUnit)
+	case params.DurationUnit != "":
+		return updateReq, moveReq, changes, fmt.Errorf("duration is required when durationUnit is set")
+	}
+
+	// Move destination
+	destinations := 0
+	for _, dest := range []string{moveReq.ProjectID, moveReq.SectionID, moveReq.ParentID} {
+		if dest != "" {
+			destinations++
+		}
+	}
+	if destinations > 1 {
+		return updateReq, moveReq, changes, fmt.Errorf("only one of projectId, sectionId, or parentId may be specified")
+	}
+
+	return updateReq, moveReq, changes, nil
 }
 
 // CloseTask returns the todoist_close_task tool
 func (tp *ToolProvider) CloseTask() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":     "object",
-		"required": []string{"id"},
-		"properties": map[string]interface{}{
-			"id": map[string]interface{}{
-				"type":        "string",
-				"description": "The unique identifier of the task to mark as completed (required). Specify the numeric Todoist task ID (e.g., '2995104339').",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[CloseTaskParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
 	// Convert the input schema to JSON
@@ -448,20 +803,24 @@ func (tp *ToolProvider) CloseTask() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_close_task",
-		Description: "Mark a task as completed.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
+		Name:         "todoist_close_task",
+		Description:  "Mark a task as completed.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
 	}
 }
 
 // HandleCloseTask handles the todoist_close_task tool request
-func (tp *ToolProvider) HandleCloseTask(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Parse parameters
-	id, err := RequiredParam[string](request, "id")
-	if err != nil {
-		return newToolResultError("Missing required parameter: id", err), nil
-	}
+func (tp *ToolProvider) HandleCloseTask(ctx context.Context, request *mcp.CallToolRequest, params CloseTaskParams) (*mcp.CallToolResult, error) {
+	id := params.ID
 
 	// Log the request
 	tp.logger.WithFields(map[string]interface{}{
@@ -469,28 +828,85 @@ func (tp *ToolProvider) HandleCloseTask(ctx context.Context, request *mcp.CallTo
 	}).Info("Closing task")
 
 	// Call the Todoist API
-	err = tp.client.CloseTask(ctx, id)
+	err := tp.client.CloseTask(ctx, id)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to close task")
-		return newToolResultError("Failed to close task", err), nil
+		return newToolResultAPIError("Failed to close task", err, "task", id), nil
 	}
 
 	// Return success response
-	return newToolResultText(`{"success": true}`), nil
+	return jsonResult(SuccessResponse{Success: true}), nil
+}
+
+// ReopenTask returns the todoist_reopen_task tool
+func (tp *ToolProvider) ReopenTask() mcp.Tool {
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[ReopenTaskParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
+	}
+
+	// Convert the input schema to JSON
+	inputSchemaJSON, err := json.Marshal(inputSchema)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to marshal input schema")
+		return mcp.Tool{}
+	}
+
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[ReopenTaskResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
+	return mcp.Tool{
+		Name:         "todoist_reopen_task",
+		Description:  "Reopen a completed task.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
+	}
+}
+
+// HandleReopenTask handles the todoist_reopen_task tool request
+func (tp *ToolProvider) HandleReopenTask(ctx context.Context, request *mcp.CallToolRequest, params ReopenTaskParams) (*mcp.CallToolResult, error) {
+	id := params.ID
+
+	// Log the request
+	tp.logger.WithFields(map[string]interface{}{
+		"id": id,
+	}).Info("Reopening task")
+
+	// Call the Todoist API
+	err := tp.client.ReopenTask(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to reopen task")
+		return newToolResultAPIError("Failed to reopen task", err, "task", id), nil
+	}
+
+	// The reopen endpoint returns no content, so fetch the task to report its new state
+	task, err := tp.client.GetTask(ctx, id)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to get reopened task")
+		return newToolResultError("Task was reopened but could not be retrieved", err), nil
+	}
+
+	// Convert task to JSON
+	response := ReopenTaskResponse{
+		Task: newTaskView(*task),
+	}
+	// Return the response
+	return jsonResult(response), nil
 }
 
 // DeleteTask returns the todoist_delete_task tool
 func (tp *ToolProvider) DeleteTask() mcp.Tool {
-	// Define the input schema for the tool
-	inputSchema := map[string]interface{}{
-		"type":     "object",
-		"required": []string{"id"},
-		"properties": map[string]interface{}{
-			"id": map[string]interface{}{
-				"type":        "string",
-				"description": "The unique identifier of the task to delete (required). Specify the numeric Todoist task ID (e.g., '2995104339'). Warning: This action is permanent and cannot be undone.",
-			},
-		},
+	// Generate the input schema from the parameters
+	inputSchema, err := inputSchemaFor[DeleteTaskParams](nil)
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate input schema")
+		return mcp.Tool{}
 	}
 
 	// Convert the input schema to JSON
@@ -500,20 +916,24 @@ func (tp *ToolProvider) DeleteTask() mcp.Tool {
 		return mcp.Tool{}
 	}
 
+	// Derive the output schema from the response type
+	outputSchemaJSON, err := outputSchema[SuccessResponse]()
+	if err != nil {
+		tp.logger.WithError(err).Error("Failed to generate output schema")
+		return mcp.Tool{}
+	}
+
 	return mcp.Tool{
-		Name:        "todoist_delete_task",
-		Description: "Delete a task.",
-		InputSchema: json.RawMessage(inputSchemaJSON),
+		Name:         "todoist_delete_task",
+		Description:  "Delete a task.",
+		InputSchema:  json.RawMessage(inputSchemaJSON),
+		OutputSchema: outputSchemaJSON,
 	}
 }
 
 // HandleDeleteTask handles the todoist_delete_task tool request
-func (tp *ToolProvider) HandleDeleteTask(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
-	// Parse parameters
-	id, err := RequiredParam[string](request, "id")
-	if err != nil {
-		return newToolResultError("Missing required parameter: id", err), nil
-	}
+func (tp *ToolProvider) HandleDeleteTask(ctx context.Context, request *mcp.CallToolRequest, params DeleteTaskParams) (*mcp.CallToolResult, error) {
+	id := params.ID
 
 	// Log the request
 	tp.logger.WithFields(map[string]interface{}{
@@ -521,14 +941,14 @@ func (tp *ToolProvider) HandleDeleteTask(ctx context.Context, request *mcp.CallT
 	}).Info("Deleting task")
 
 	// Call the Todoist API
-	err = tp.client.DeleteTask(ctx, id)
+	err := tp.client.DeleteTask(ctx, id)
 	if err != nil {
 		tp.logger.WithError(err).Error("Failed to delete task")
-		return newToolResultError("Failed to delete task", err), nil
+		return newToolResultAPIError("Failed to delete task", err, "task", id), nil
 	}
 
 	// Return success response
-	return newToolResultText(`{"success": true}`), nil
+	return jsonResult(SuccessResponse{Success: true}), nil
 }
 
 // getArguments extracts arguments from a CallToolRequest as a map
@@ -548,7 +968,8 @@ func getArguments(r *mcp.CallToolRequest) (map[string]interface{}, error) {
 // OptionalParam is a helper function that can be used to fetch a requested parameter from the request.
 // It does the following checks:
 // 1. Checks if the parameter is present in the request, if not, it returns its zero-value
-// 2. If it is present, it checks if the parameter is of the expected type and returns it
+// 2. If it is present, it checks if the parameter is of the expected type and returns it.
+// Numeric parameters also accept numeric strings; see paramValue.
 func OptionalParam[T any](r *mcp.CallToolRequest, p string) (T, error) {
 	var zero T
 	args, err := getArguments(r)
@@ -562,11 +983,30 @@ func OptionalParam[T any](r *mcp.CallToolRequest, p string) (T, error) {
 	}
 
 	// Check if the parameter is of the expected type
-	if _, ok := args[p].(T); !ok {
-		return zero, fmt.Errorf("parameter %s is not of the expected type", p)
+	return paramValue[T](p, args[p])
+}
+
+// OptionalPtrParam is a helper function that can be used to fetch an optional parameter
+// whose absence must be distinguishable from its zero value.
+// It returns nil if the parameter is not present, and an error if it is of the wrong type.
+func OptionalPtrParam[T any](r *mcp.CallToolRequest, p string) (*T, error) {
+	args, err := getArguments(r)
+	if err != nil {
+		return nil, err
+	}
+
+	// Check if the parameter is present in the request
+	if _, ok := args[p]; !ok {
+		return nil, nil
+	}
+
+	// Check if the parameter is of the expected type
+	v, err := paramValue[T](p, args[p])
+	if err != nil {
+		return nil, err
 	}
 
-	return args[p].(T), nil
+	return &v, nil
 }
 
 // OptionalStringArrayParam is a helper function that can be used to fetch a requested parameter from the request.
@@ -619,11 +1059,7 @@ func RequiredParam[T any](r *mcp.CallToolRequest, p string) (T, error) {
 	}
 
 	// Check if the parameter is of the expected type
-	if _, ok := args[p].(T); !ok {
-		return zero, fmt.Errorf("parameter %s is not of the expected type", p)
-	}
-
-	return args[p].(T), nil
+	return paramValue[T](p, args[p])
 }
 
 // newToolResultText creates a CallToolResult with text content
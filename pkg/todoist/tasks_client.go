@@ -5,35 +5,66 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"iter"
 	"net/http"
 	"net/url"
+	"strconv"
+	"time"
 )
 
 // GetTasks retrieves active tasks. If filter is provided, uses the /tasks/filter endpoint.
 // Otherwise uses /tasks with optional projectID parameter.
+// All pages are fetched by following next_cursor, up to the client's max items cap.
 func (c *Client) GetTasks(ctx context.Context, projectID, filter string) ([]Task, error) {
+	return collect(c.AllTasks(ctx, projectID, filter))
+}
+
+// AllTasks returns an iterator over active tasks that fetches pages lazily,
+// so large result sets can be streamed without loading them into memory.
+// The projectID and filter arguments behave as in GetTasks.
+func (c *Client) AllTasks(ctx context.Context, projectID, filter string) iter.Seq2[Task, error] {
 	// Filter uses a separate endpoint in API v1
 	if filter != "" {
-		return c.getTasksByFilter(ctx, filter)
+		return c.tasksByFilter(ctx, filter)
 	}
 
-	endpoint := "/tasks"
-
-	// Add query parameters if provided
+	params := url.Values{}
 	if projectID != "" {
-		u, err := url.Parse(endpoint)
-		if err != nil {
-			return nil, fmt.Errorf("failed to parse endpoint: %w", err)
-		}
-		q := u.Query()
-		q.Add("project_id", projectID)
-		u.RawQuery = q.Encode()
-		endpoint = u.String()
+		params.Set("project_id", projectID)
 	}
 
+	return paginate[Task](ctx, c, "/tasks", params, "get tasks")
+}
+
+// tasksByFilter iterates over tasks using the /tasks/filter endpoint
+func (c *Client) tasksByFilter(ctx context.Context, filter string) iter.Seq2[Task, error] {
+	params := url.Values{}
+	params.Set("query", filter)
+
+	return paginate[Task](ctx, c, "/tasks/filter", params, "get tasks by filter")
+}
+
+// GetCompletedTasks retrieves one page of tasks completed between req.Since and req.Until,
+// starting at req.Cursor. The Todoist API limits the range to 3 months.
+// The returned page's NextCursor is nil when there are no further pages.
+func (c *Client) GetCompletedTasks(ctx context.Context, req CompletedTasksRequest) (*CompletedTasksPage, error) {
+	params := url.Values{}
+	params.Set("since", req.Since.UTC().Format(time.RFC3339))
+	params.Set("until", req.Until.UTC().Format(time.RFC3339))
+	if req.ProjectID != "" {
+		params.Set("project_id", req.ProjectID)
+	}
+	if c.pageSize > 0 {
+		params.Set("limit", strconv.Itoa(c.pageSize))
+	}
+	if req.Cursor != "" {
+		params.Set("cursor", req.Cursor)
+	}
+	endpoint := "/tasks/completed/by_completion_date?" + params.Encode()
+
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tasks: %w", err)
+		return nil, fmt.Errorf("failed to get completed tasks: %w", err)
 	}
 
 	bodyBytes, err := c.processResponse(resp, http.StatusOK)
@@ -41,31 +72,61 @@ func (c *Client) GetTasks(ctx context.Context, projectID, filter string) ([]Task
 		return nil, err
 	}
 
-	// Parse paginated response
-	var paginatedResp PaginatedResponse[Task]
-	if err := json.Unmarshal(bodyBytes, &paginatedResp); err != nil {
+	// Parse response
+	var page CompletedTasksPage
+	if err := json.Unmarshal(bodyBytes, &page); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
+	if page.Items == nil {
+		page.Items = []Task{}
+	}
 
-	return paginatedResp.Results, nil
+	return &page, nil
 }
 
-// getTasksByFilter retrieves tasks using the /tasks/filter endpoint
-func (c *Client) getTasksByFilter(ctx context.Context, filter string) ([]Task, error) {
-	endpoint := "/tasks/filter"
-
-	u, err := url.Parse(endpoint)
-	if err != nil {
-		return nil, fmt.Errorf("failed to parse endpoint: %w", err)
+// AllCompletedTasks returns an iterator over every task completed between req.Since and
+// req.Until, following next_cursor up to the client's max items cap.
+// The completed endpoints return their results under "items" rather than "results",
+// so they are paged here instead of through paginate.
+func (c *Client) AllCompletedTasks(ctx context.Context, req CompletedTasksRequest) iter.Seq2[Task, error] {
+	return func(yield func(Task, error) bool) {
+		count := 0
+		for {
+			page, err := c.GetCompletedTasks(ctx, req)
+			if err != nil {
+				yield(Task{}, err)
+				return
+			}
+
+			for _, task := range page.Items {
+				if c.maxItems > 0 && count >= c.maxItems {
+					return
+				}
+				if !yield(task, nil) {
+					return
+				}
+				count++
+			}
+
+			// A full cap needs no further page
+			if c.maxItems > 0 && count >= c.maxItems {
+				return
+			}
+			if page.NextCursor == nil || *page.NextCursor == "" || *page.NextCursor == req.Cursor {
+				return
+			}
+			req.Cursor = *page.NextCursor
+		}
 	}
-	q := u.Query()
-	q.Add("query", filter)
-	u.RawQuery = q.Encode()
-	endpoint = u.String()
+}
+
+// GetTask retrieves a specific task by ID
+func (c *Client) GetTask(ctx context.Context, id string) (*Task, error) {
+	endpoint := fmt.Sprintf("/tasks/%s", id)
 
 	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tasks by filter: %w", err)
+		return nil, fmt.Errorf("failed to get task: %w", err)
 	}
 
 	bodyBytes, err := c.processResponse(resp, http.StatusOK)
@@ -73,22 +134,28 @@ func (c *Client) getTasksByFilter(ctx context.Context, filter string) ([]Task, e
 		return nil, err
 	}
 
-	// Parse paginated response
-	var paginatedResp PaginatedResponse[Task]
-	if err := json.Unmarshal(bodyBytes, &paginatedResp); err != nil {
+	// Parse response
+	var task Task
+	if err := json.Unmarshal(bodyBytes, &task); err != nil {
 		return nil, fmt.Errorf("failed to decode response: %w", err)
 	}
 
-	return paginatedResp.Results, nil
+	return &task, nil
 }
 
-// GetTask retrieves a specific task by ID
-func (c *Client) GetTask(ctx context.Context, id string) (*Task, error) {
-	endpoint := fmt.Sprintf("/tasks/%s", id)
+// CreateTask creates a new task
+func (c *Client) CreateTask(ctx context.Context, req CreateTaskRequest) (*Task, error) {
+	endpoint := "/tasks"
 
-	resp, err := c.doRequest(ctx, "GET", endpoint, nil)
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get task: %w", err)
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create task: %w", err)
 	}
 
 	bodyBytes, err := c.processResponse(resp, http.StatusOK)
@@ -105,9 +172,10 @@ func (c *Client) GetTask(ctx context.Context, id string) (*Task, error) {
 	return &task, nil
 }
 
-// CreateTask creates a new task
-func (c *Client) CreateTask(ctx context.Context, req CreateTaskRequest) (*Task, error) {
-	endpoint := "/tasks"
+// QuickAddTask creates a task from a natural-language string using the Quick Add parser,
+// which resolves #project, /section, @label, priority and date syntax server-side
+func (c *Client) QuickAddTask(ctx context.Context, req QuickAddTaskRequest) (*Task, error) {
+	endpoint := "/tasks/quick"
 
 	// Convert request to JSON
 	reqBody, err := json.Marshal(req)
@@ -117,7 +185,7 @@ func (c *Client) CreateTask(ctx context.Context, req CreateTaskRequest) (*Task,
 
 	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
 	if err != nil {
-		return nil, fmt.Errorf("failed to create task: %w", err)
+		return nil, fmt.Errorf("failed to quick add task: %w", err)
 	}
 
 	bodyBytes, err := c.processResponse(resp, http.StatusOK)
@@ -163,6 +231,35 @@ func (c *Client) UpdateTask(ctx context.Context, id string, req UpdateTaskReques
 	return &task, nil
 }
 
+// MoveTask moves a task to another project, section or parent task
+func (c *Client) MoveTask(ctx context.Context, id string, req MoveTaskRequest) (*Task, error) {
+	endpoint := fmt.Sprintf("/tasks/%s/move", id)
+
+	// Convert request to JSON
+	reqBody, err := json.Marshal(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	resp, err := c.doRequest(ctx, "POST", endpoint, bytes.NewBuffer(reqBody))
+	if err != nil {
+		return nil, fmt.Errorf("failed to move task: %w", err)
+	}
+
+	bodyBytes, err := c.processResponse(resp, http.StatusOK)
+	if err != nil {
+		return nil, err
+	}
+
+	// Parse response
+	var task Task
+	if err := json.Unmarshal(bodyBytes, &task); err != nil {
+		return nil, fmt.Errorf("failed to decode response: %w", err)
+	}
+
+	return &task, nil
+}
+
 // CloseTask marks a task as completed
 func (c *Client) CloseTask(ctx context.Context, id string) error {
 	endpoint := fmt.Sprintf("/tasks/%s/close", id)
@@ -283,6 +283,106 @@ func TestHandleGetTask(t *testing.T) {
 	}
 }
 
+func TestGetCompletedTasksTool(t *testing.T) {
+	tp := NewMockToolProvider()
+
+	tool := tp.GetCompletedTasks()
+	assert.Equal(t, "todoist_get_completed_tasks", tool.Name)
+	assert.True(t, tool.Annotations.ReadOnlyHint)
+
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+
+	var schema map[string]interface{}
+	assert.NoError(t, json.Unmarshal(schemaBytes, &schema))
+
+	properties, ok := schema["properties"].(map[string]interface{})
+	assert.True(t, ok)
+	for _, name := range []string{"since", "until", "projectId", "cursor"} {
+		assert.Contains(t, properties, name)
+	}
+}
+
+func TestHandleGetCompletedTasks(t *testing.T) {
+	tests := []struct {
+		name           string
+		params         map[string]interface{}
+		wantIsError    bool
+		wantSince      string
+		wantUntil      string
+		wantNextCursor string
+	}{
+		{
+			name: "date range",
+			params: map[string]interface{}{
+				"since":     "2025-01-01",
+				"until":     "2025-01-07",
+				"projectId": "2203306141",
+			},
+			wantSince:      "2025-01-01T00:00:00Z",
+			wantUntil:      "2025-01-07T23:59:59Z",
+			wantNextCursor: "next",
+		},
+		{
+			name: "rfc3339 range",
+			params: map[string]interface{}{
+				"since": "2025-01-01T09:00:00+09:00",
+				"until": "2025-01-02T09:00:00+09:00",
+			},
+			wantSince:      "2025-01-01T00:00:00Z",
+			wantUntil:      "2025-01-02T00:00:00Z",
+			wantNextCursor: "next",
+		},
+		{
+			name: "invalid date",
+			params: map[string]interface{}{
+				"since": "last week",
+			},
+			wantIsError: true,
+		},
+		{
+			name: "since after until",
+			params: map[string]interface{}{
+				"since": "2025-01-07",
+				"until": "2025-01-01",
+			},
+			wantIsError: true,
+		},
+		{
+			name: "range longer than 3 months",
+			params: map[string]interface{}{
+				"since": "2025-01-01",
+				"until": "2025-06-01",
+			},
+			wantIsError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, tt.wantSince, req.URL.Query().Get("since"))
+				assert.Equal(t, tt.wantUntil, req.URL.Query().Get("until"))
+				return MockResponse(200, CompletedTasksPage{
+					Items:      []Task{*MockTask()},
+					NextCursor: strPtr("next"),
+				}), nil
+			})
+
+			result, err := CallTypedTool(context.Background(), tp.HandleGetCompletedTasks, MockCallToolRequest(tt.params))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIsError, result.IsError)
+
+			if !tt.wantIsError {
+				var response GetCompletedTasksResponse
+				assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+				assert.Len(t, response.Tasks, 1)
+				assert.Equal(t, tt.wantNextCursor, response.NextCursor)
+			}
+		})
+	}
+}
+
 func TestCreateTaskTool(t *testing.T) {
 	// Create tool provider
 	tp := NewMockToolProvider()
@@ -292,7 +392,7 @@ func TestCreateTaskTool(t *testing.T) {
 
 	// Check tool properties
 	assert.Equal(t, "todoist_create_task", tool.Name)
-	assert.Equal(t, "Create a new task.", tool.Description)
+	assert.Equal(t, "Create a new task, optionally with a tree of subtasks such as a checklist.", tool.Description)
 
 	// Check input schema
 	schemaBytes, err := json.Marshal(tool.InputSchema)
@@ -321,6 +421,13 @@ func TestCreateTaskTool(t *testing.T) {
 	description, ok := properties["description"].(map[string]interface{})
 	assert.True(t, ok)
 	assert.Equal(t, "string", description["type"])
+
+	// Check subtasks property, which nests through $defs
+	subtasks, ok := properties["subtasks"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, []interface{}{"null", "array"}, subtasks["type"])
+	assert.Equal(t, map[string]interface{}{"$ref": "#/$defs/SubtaskParams"}, subtasks["items"])
+	assert.Contains(t, schema["$defs"], "SubtaskParams")
 }
 
 func TestHandleCreateTask(t *testing.T) {
@@ -428,6 +535,85 @@ func TestHandleCreateTask(t *testing.T) {
 	}
 }
 
+func TestQuickAddTaskTool(t *testing.T) {
+	tp := NewMockToolProvider()
+
+	tool := tp.QuickAddTask()
+	assert.Equal(t, "todoist_quick_add_task", tool.Name)
+
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+
+	var schema map[string]interface{}
+	assert.NoError(t, json.Unmarshal(schemaBytes, &schema))
+	assert.Equal(t, []interface{}{"text"}, schema["required"])
+
+	properties, ok := schema["properties"].(map[string]interface{})
+	assert.True(t, ok)
+	for _, name := range []string{"text", "note", "reminder", "autoReminder"} {
+		assert.Contains(t, properties, name)
+	}
+}
+
+func TestHandleQuickAddTask(t *testing.T) {
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		statusCode  int
+		wantIsError bool
+		wantBody    map[string]interface{}
+	}{
+		{
+			name: "success",
+			params: map[string]interface{}{
+				"text":         "Call mom tomorrow 5pm #Family @phone p1",
+				"autoReminder": true,
+			},
+			statusCode: 200,
+			wantBody: map[string]interface{}{
+				"text":          "Call mom tomorrow 5pm #Family @phone p1",
+				"auto_reminder": true,
+			},
+		},
+		{
+			name: "api error",
+			params: map[string]interface{}{
+				"text": "Call mom",
+			},
+			statusCode:  400,
+			wantIsError: true,
+			wantBody: map[string]interface{}{
+				"text": "Call mom",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody map[string]interface{}
+			tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+				assert.Equal(t, "/api/v1/tasks/quick", req.URL.Path)
+				assert.NoError(t, json.NewDecoder(req.Body).Decode(&gotBody))
+				if tt.statusCode != 200 {
+					return MockResponse(tt.statusCode, nil), nil
+				}
+				return MockResponse(200, MockTask()), nil
+			})
+
+			result, err := CallTypedTool(context.Background(), tp.HandleQuickAddTask, MockCallToolRequest(tt.params))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIsError, result.IsError)
+			assert.Equal(t, tt.wantBody, gotBody)
+
+			if !tt.wantIsError {
+				var response QuickAddTaskResponse
+				assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+				assert.Equal(t, "123456789", response.Task.ID)
+			}
+		})
+	}
+}
+
 func TestUpdateTaskTool(t *testing.T) {
 	// Create tool provider
 	tp := NewMockToolProvider()
@@ -437,7 +623,7 @@ func TestUpdateTaskTool(t *testing.T) {
 
 	// Check tool properties
 	assert.Equal(t, "todoist_update_task", tool.Name)
-	assert.Equal(t, "Update an existing task.", tool.Description)
+	assert.Equal(t, "Update an existing task, including moving it to another project, section or parent task.", tool.Description)
 
 	// Check input schema
 	schemaBytes, err := json.Marshal(tool.InputSchema)
@@ -466,6 +652,11 @@ func TestUpdateTaskTool(t *testing.T) {
 	content, ok := properties["content"].(map[string]interface{})
 	assert.True(t, ok)
 	assert.Equal(t, "string", content["type"])
+
+	// Check full field coverage
+	for _, name := range []string{"labels", "addLabels", "removeLabels", "assigneeId", "duration", "durationUnit", "deadlineDate", "projectId", "sectionId", "parentId"} {
+		assert.Contains(t, properties, name)
+	}
 }
 
 func TestHandleUpdateTask(t *testing.T) {
@@ -573,6 +764,172 @@ func TestHandleUpdateTask(t *testing.T) {
 	}
 }
 
+func TestHandleUpdateTaskFields(t *testing.T) {
+	current := *MockTask()
+	current.Labels = []string{"work", "urgent"}
+
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		wantIsError bool
+		wantCalls   []string
+		wantBodies  []map[string]interface{}
+	}{
+		{
+			name: "clear values",
+			params: map[string]interface{}{
+				"id":           "123456789",
+				"description":  "",
+				"dueString":    nil,
+				"assigneeId":   nil,
+				"duration":     nil,
+				"deadlineDate": nil,
+			},
+			wantCalls: []string{"POST /api/v1/tasks/123456789"},
+			wantBodies: []map[string]interface{}{{
+				"description":   "",
+				"due_string":    "no date",
+				"assignee_id":   nil,
+				"duration":      nil,
+				"duration_unit": nil,
+				"deadline_date": nil,
+			}},
+		},
+		{
+			name: "set duration and deadline",
+			params: map[string]interface{}{
+				"id":           "123456789",
+				"assigneeId":   "42",
+				"duration":     float64(30),
+				"durationUnit": "minute",
+				"deadlineDate": "2025-12-31",
+			},
+			wantCalls: []string{"POST /api/v1/tasks/123456789"},
+			wantBodies: []map[string]interface{}{{
+				"assignee_id":   "42",
+				"duration":      float64(30),
+				"duration_unit": "minute",
+				"deadline_date": "2025-12-31",
+			}},
+		},
+		{
+			name: "replace labels",
+			params: map[string]interface{}{
+				"id":     "123456789",
+				"labels": []interface{}{},
+			},
+			wantCalls:  []string{"POST /api/v1/tasks/123456789"},
+			wantBodies: []map[string]interface{}{{"labels": []interface{}{}}},
+		},
+		{
+			name: "add and remove labels",
+			params: map[string]interface{}{
+				"id":           "123456789",
+				"addLabels":    []interface{}{"home", "work"},
+				"removeLabels": []interface{}{"urgent"},
+			},
+			wantCalls:  []string{"GET /api/v1/tasks/123456789", "POST /api/v1/tasks/123456789"},
+			wantBodies: []map[string]interface{}{{"labels": []interface{}{"work", "home"}}},
+		},
+		{
+			name: "move only",
+			params: map[string]interface{}{
+				"id":        "123456789",
+				"sectionId": "7025",
+			},
+			wantCalls:  []string{"POST /api/v1/tasks/123456789/move"},
+			wantBodies: []map[string]interface{}{{"section_id": "7025"}},
+		},
+		{
+			name: "update and move",
+			params: map[string]interface{}{
+				"id":        "123456789",
+				"content":   "Moved Task",
+				"projectId": "2203306141",
+			},
+			wantCalls: []string{"POST /api/v1/tasks/123456789", "POST /api/v1/tasks/123456789/move"},
+			wantBodies: []map[string]interface{}{
+				{"content": "Moved Task"},
+				{"project_id": "2203306141"},
+			},
+		},
+		{
+			name: "multiple move destinations",
+			params: map[string]interface{}{
+				"id":        "123456789",
+				"projectId": "2203306141",
+				"parentId":  "987654321",
+			},
+			wantIsError: true,
+		},
+		{
+			name: "clear and set due date",
+			params: map[string]interface{}{
+				"id":        "123456789",
+				"dueString": nil,
+				"dueDate":   "2025-01-01",
+			},
+			wantIsError: true,
+		},
+		{
+			name: "set and clear due date",
+			params: map[string]interface{}{
+				"id":        "123456789",
+				"dueString": "tomorrow",
+				"dueDate":   nil,
+			},
+			wantIsError: true,
+		},
+		{
+			name: "multiple due dates",
+			params: map[string]interface{}{
+				"id":          "123456789",
+				"dueDate":     "2025-01-01",
+				"dueDatetime": "2025-01-01T10:00:00Z",
+			},
+			wantIsError: true,
+		},
+		{
+			name: "duration without unit",
+			params: map[string]interface{}{
+				"id":       "123456789",
+				"duration": float64(30),
+			},
+			wantIsError: true,
+		},
+		{
+			name: "unit without duration",
+			params: map[string]interface{}{
+				"id":           "123456789",
+				"durationUnit": "day",
+			},
+			wantIsError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			var bodies []map[string]interface{}
+			tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, req.Method+" "+req.URL.Path)
+				if req.Body != nil {
+					var body map[string]interface{}
+					assert.NoError(t, json.NewDecoder(req.Body).Decode(&body))
+					bodies = append(bodies, body)
+				}
+				return MockResponse(200, current), nil
+			})
+
+			result, err := CallTypedTool(context.Background(), tp.HandleUpdateTask, MockCallToolRequest(tt.params))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIsError, result.IsError)
+			assert.Equal(t, tt.wantCalls, calls)
+			assert.Equal(t, tt.wantBodies, bodies)
+		})
+	}
+}
+
 func TestCloseTaskTool(t *testing.T) {
 	// Create tool provider
 	tp := NewMockToolProvider()
@@ -693,6 +1050,114 @@ func TestHandleCloseTask(t *testing.T) {
 	}
 }
 
+func TestReopenTaskTool(t *testing.T) {
+	// Create tool provider
+	tp := NewMockToolProvider()
+
+	// Get the tool
+	tool := tp.ReopenTask()
+
+	// Check tool properties
+	assert.Equal(t, "todoist_reopen_task", tool.Name)
+	assert.Equal(t, "Reopen a completed task.", tool.Description)
+	assert.Nil(t, tool.Annotations)
+
+	// Check input schema
+	schemaBytes, err := json.Marshal(tool.InputSchema)
+	assert.NoError(t, err)
+
+	var schema map[string]interface{}
+	err = json.Unmarshal(schemaBytes, &schema)
+	assert.NoError(t, err)
+
+	// Check schema type and required fields
+	assert.Equal(t, "object", schema["type"])
+	required, ok := schema["required"].([]interface{})
+	assert.True(t, ok)
+	assert.Contains(t, required, "id")
+
+	// Check properties
+	properties, ok := schema["properties"].(map[string]interface{})
+	assert.True(t, ok)
+
+	// Check id property
+	id, ok := properties["id"].(map[string]interface{})
+	assert.True(t, ok)
+	assert.Equal(t, "string", id["type"])
+}
+
+func TestHandleReopenTask(t *testing.T) {
+	reopened := *MockTask()
+	reopened.Checked = false
+
+	tests := []struct {
+		name        string
+		params      map[string]interface{}
+		reopenCode  int
+		getCode     int
+		wantIsError bool
+		wantCalls   []string
+	}{
+		{
+			name: "success returns reopened task",
+			params: map[string]interface{}{
+				"id": "123456789",
+			},
+			reopenCode:  204,
+			getCode:     200,
+			wantIsError: false,
+			wantCalls:   []string{"POST /api/v1/tasks/123456789/reopen", "GET /api/v1/tasks/123456789"},
+		},
+		{
+			name: "api error",
+			params: map[string]interface{}{
+				"id": "123456789",
+			},
+			reopenCode:  404,
+			wantIsError: true,
+			wantCalls:   []string{"POST /api/v1/tasks/123456789/reopen"},
+		},
+		{
+			name: "task lookup fails after reopen",
+			params: map[string]interface{}{
+				"id": "123456789",
+			},
+			reopenCode:  204,
+			getCode:     500,
+			wantIsError: true,
+			wantCalls:   []string{"POST /api/v1/tasks/123456789/reopen", "GET /api/v1/tasks/123456789"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var calls []string
+			tp := NewMockToolProviderWithClient(func(req *http.Request) (*http.Response, error) {
+				calls = append(calls, req.Method+" "+req.URL.Path)
+				if req.Method == "POST" {
+					return MockResponse(tt.reopenCode, nil), nil
+				}
+				if tt.getCode != 200 {
+					return MockResponse(tt.getCode, nil), nil
+				}
+				return MockResponse(200, reopened), nil
+			})
+
+			result, err := CallTypedTool(context.Background(), tp.HandleReopenTask, MockCallToolRequest(tt.params))
+			assert.NoError(t, err)
+			assert.Equal(t, tt.wantIsError, result.IsError)
+			assert.Equal(t, tt.wantCalls, calls)
+
+			if !tt.wantIsError {
+				var response ReopenTaskResponse
+				assert.NoError(t, json.Unmarshal([]byte(resultText(result)), &response))
+				assert.Equal(t, "123456789", response.Task.ID)
+				assert.False(t, response.Task.Checked)
+			}
+		})
+	}
+}
+
 func TestDeleteTaskTool(t *testing.T) {
 	// Create tool provider
 	tp := NewMockToolProvider()
@@ -9,10 +9,12 @@ import (
 type ToolProvider struct {
 	client TodoistClient
 	logger *logrus.Logger
+	names  nameCache
 }
 
-// NewToolProvider creates a new ToolProvider
-func NewToolProvider(token string, logger *logrus.Logger) *ToolProvider {
+// NewToolProvider creates a new ToolProvider.
+// The options are applied to the Todoist API client after the defaults.
+func NewToolProvider(token string, logger *logrus.Logger, options ...ClientOption) *ToolProvider {
 	if logger == nil {
 		logger = logrus.New()
 		logger.SetFormatter(&logrus.TextFormatter{
@@ -20,7 +22,7 @@ func NewToolProvider(token string, logger *logrus.Logger) *ToolProvider {
 		})
 	}
 
-	client := NewClient(token, WithLogger(logger))
+	client := NewClient(token, append([]ClientOption{WithLogger(logger), WithRetryPolicy(DefaultRetryPolicy)}, options...)...)
 
 	return &ToolProvider{
 		client: client,
@@ -31,43 +33,107 @@ func NewToolProvider(token string, logger *logrus.Logger) *ToolProvider {
 // GetTools returns all Todoist tools
 func (tp *ToolProvider) GetTools() []toolsets.ServerTool {
 	// Return all tools
-	return []toolsets.ServerTool{
+	tools := []toolsets.ServerTool{
+		toolsets.NewTypedServerTool(tp.GetTasks(), tp.HandleGetTasks),
+		toolsets.NewTypedServerTool(tp.GetTask(), tp.HandleGetTask),
+		toolsets.NewTypedServerTool(tp.GetCompletedTasks(), tp.HandleGetCompletedTasks),
+		toolsets.NewTypedServerTool(tp.CreateTask(), tp.HandleCreateTask),
+		toolsets.NewTypedServerTool(tp.QuickAddTask(), tp.HandleQuickAddTask),
+		toolsets.NewTypedServerTool(tp.UpdateTask(), tp.HandleUpdateTask),
+		toolsets.NewTypedServerTool(tp.CloseTask(), tp.HandleCloseTask),
+		toolsets.NewTypedServerTool(tp.ReopenTask(), tp.HandleReopenTask),
+		toolsets.NewTypedServerTool(tp.DeleteTask(), tp.HandleDeleteTask),
+		toolsets.NewTypedServerTool(tp.GetProjects(), tp.HandleGetProjects),
+		toolsets.NewTypedServerTool(tp.GetProject(), tp.HandleGetProject),
+		toolsets.NewTypedServerTool(tp.CreateProject(), tp.HandleCreateProject),
+		toolsets.NewTypedServerTool(tp.UpdateProject(), tp.HandleUpdateProject),
+		toolsets.NewTypedServerTool(tp.ArchiveProject(), tp.HandleArchiveProject),
+		toolsets.NewTypedServerTool(tp.UnarchiveProject(), tp.HandleUnarchiveProject),
+		toolsets.NewTypedServerTool(tp.DeleteProject(), tp.HandleDeleteProject),
 		{
-			Tool:    tp.GetTasks(),
-			Handler: tp.HandleGetTasks,
+			Tool:    tp.GetTaskFilterRules(),
+			Handler: tp.HandleGetTaskFilterRules,
 		},
 		{
-			Tool:    tp.GetTask(),
-			Handler: tp.HandleGetTask,
+			Tool:    tp.GetLabels(),
+			Handler: tp.HandleGetLabels,
 		},
 		{
-			Tool:    tp.CreateTask(),
-			Handler: tp.HandleCreateTask,
+			Tool:    tp.GetLabel(),
+			Handler: tp.HandleGetLabel,
 		},
 		{
-			Tool:    tp.UpdateTask(),
-			Handler: tp.HandleUpdateTask,
+			Tool:    tp.CreateLabel(),
+			Handler: tp.HandleCreateLabel,
 		},
 		{
-			Tool:    tp.CloseTask(),
-			Handler: tp.HandleCloseTask,
+			Tool:    tp.UpdateLabel(),
+			Handler: tp.HandleUpdateLabel,
 		},
 		{
-			Tool:    tp.DeleteTask(),
-			Handler: tp.HandleDeleteTask,
+			Tool:    tp.DeleteLabel(),
+			Handler: tp.HandleDeleteLabel,
 		},
 		{
-			Tool:    tp.GetProjects(),
-			Handler: tp.HandleGetProjects,
+			Tool:    tp.RenameSharedLabel(),
+			Handler: tp.HandleRenameSharedLabel,
 		},
 		{
-			Tool:    tp.GetProject(),
-			Handler: tp.HandleGetProject,
+			Tool:    tp.GetSections(),
+			Handler: tp.HandleGetSections,
 		},
 		{
-			Tool:    tp.GetTaskFilterRules(),
-			Handler: tp.HandleGetTaskFilterRules,
+			Tool:    tp.GetSection(),
+			Handler: tp.HandleGetSection,
+		},
+		{
+			Tool:    tp.CreateSection(),
+			Handler: tp.HandleCreateSection,
+		},
+		{
+			Tool:    tp.UpdateSection(),
+			Handler: tp.HandleUpdateSection,
+		},
+		{
+			Tool:    tp.ReorderSections(),
+			Handler: tp.HandleReorderSections,
+		},
+		{
+			Tool:    tp.DeleteSection(),
+			Handler: tp.HandleDeleteSection,
+		},
+		{
+			Tool:    tp.MoveTaskToSection(),
+			Handler: tp.HandleMoveTaskToSection,
+		},
+		{
+			Tool:    tp.GetComments(),
+			Handler: tp.HandleGetComments,
+		},
+		{
+			Tool:    tp.AddComment(),
+			Handler: tp.HandleAddComment,
+		},
+		{
+			Tool:    tp.UpdateComment(),
+			Handler: tp.HandleUpdateComment,
+		},
+		{
+			Tool:    tp.DeleteComment(),
+			Handler: tp.HandleDeleteComment,
+		},
+		{
+			Tool:    tp.Batch(),
+			Handler: tp.HandleBatch,
 		},
 		// Add other tools here
 	}
+
+	// Write tools accept a requestId for idempotency
+	for i, tool := range tools {
+		if tool.Tool.Annotations == nil || !tool.Tool.Annotations.ReadOnlyHint {
+			tools[i] = tp.withRequestID(tool)
+		}
+	}
+	return tools
 }
@@ -2,18 +2,32 @@ package toolsets
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 
+	"github.com/google/jsonschema-go/jsonschema"
 	"github.com/modelcontextprotocol/go-sdk/mcp"
 )
 
 // ToolHandlerFunc is a handler function for a tool call.
 type ToolHandlerFunc func(context.Context, *mcp.CallToolRequest) (*mcp.CallToolResult, error)
 
+// TypedToolHandlerFunc is a handler function for a tool call whose arguments are decoded into In.
+type TypedToolHandlerFunc[In any] func(context.Context, *mcp.CallToolRequest, In) (*mcp.CallToolResult, error)
+
+// ToolMiddleware wraps the handler of a tool, e.g. to prepare the context of each call.
+type ToolMiddleware func(ToolHandlerFunc) ToolHandlerFunc
+
 // ServerTool is a tool definition bound to a handler.
 type ServerTool struct {
 	Tool    mcp.Tool
 	Handler ToolHandlerFunc
+	// addTyped adds a tool created by NewTypedServerTool to a server, validating and
+	// decoding the arguments before the middleware and typed handler run
+	addTyped   func(s *mcp.Server, tool *mcp.Tool, middleware []ToolMiddleware)
+	middleware []ToolMiddleware
 }
 
 // NewServerTool creates a new server tool
@@ -21,6 +35,122 @@ func NewServerTool(tool mcp.Tool, handler ToolHandlerFunc) ServerTool {
 	return ServerTool{Tool: tool, Handler: handler}
 }
 
+// NewTypedServerTool creates a server tool whose arguments are decoded into In.
+// If the tool has no input schema, it is generated from In. When the tool is registered,
+// its arguments are validated against the schema before the handler runs, and invalid
+// arguments are reported as a tool error, so that the model can correct the call.
+// Handler decodes the arguments without validating them, for calling the tool directly.
+func NewTypedServerTool[In any](tool mcp.Tool, handler TypedToolHandlerFunc[In]) ServerTool {
+	if tool.InputSchema == nil {
+		schema, err := jsonschema.For[In](nil)
+		if err != nil {
+			panic(fmt.Sprintf("tool (%s) has no input schema and none can be generated: %v", tool.Name, err))
+		}
+		tool.InputSchema = schema
+	}
+	resolved, err := resolveSchema(tool.InputSchema)
+	if err != nil {
+		panic(fmt.Sprintf("tool (%s) has an invalid input schema: %v", tool.Name, err))
+	}
+
+	return ServerTool{
+		Tool: tool,
+		Handler: func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+			var input In
+			if request.Params != nil && len(request.Params.Arguments) > 0 {
+				if err := json.Unmarshal(request.Params.Arguments, &input); err != nil {
+					return nil, fmt.Errorf("invalid arguments: %w", err)
+				}
+			}
+			return handler(ctx, request, input)
+		},
+		addTyped: func(s *mcp.Server, tool *mcp.Tool, middleware []ToolMiddleware) {
+			s.AddTool(tool, func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+				input, err := decodeArguments[In](resolved, request)
+				if err != nil {
+					return newToolResultError("Invalid arguments", err), nil
+				}
+				next := func(ctx context.Context, request *mcp.CallToolRequest) (*mcp.CallToolResult, error) {
+					return handler(ctx, request, input)
+				}
+				for _, m := range middleware {
+					next = m(next)
+				}
+				return next(ctx, request)
+			})
+		},
+	}
+}
+
+// resolveSchema resolves an input schema given as a *jsonschema.Schema or any value
+// that marshals to one, such as json.RawMessage
+func resolveSchema(inputSchema any) (*jsonschema.Resolved, error) {
+	schema, ok := inputSchema.(*jsonschema.Schema)
+	if !ok {
+		schemaJSON, err := json.Marshal(inputSchema)
+		if err != nil {
+			return nil, err
+		}
+		schema = &jsonschema.Schema{}
+		if err := json.Unmarshal(schemaJSON, schema); err != nil {
+			return nil, err
+		}
+	}
+	return schema.Resolve(&jsonschema.ResolveOptions{ValidateDefaults: true})
+}
+
+// decodeArguments applies the defaults of the input schema to the arguments of a call,
+// validates them against it and decodes them into In
+func decodeArguments[In any](resolved *jsonschema.Resolved, request *mcp.CallToolRequest) (In, error) {
+	var input In
+
+	args := map[string]any{}
+	if request.Params != nil && len(request.Params.Arguments) > 0 {
+		if err := json.Unmarshal(request.Params.Arguments, &args); err != nil {
+			return input, err
+		}
+	}
+	if err := resolved.ApplyDefaults(&args); err != nil {
+		return input, err
+	}
+	if err := resolved.Validate(&args); err != nil {
+		return input, err
+	}
+
+	argsJSON, err := json.Marshal(args)
+	if err != nil {
+		return input, err
+	}
+	if err := json.Unmarshal(argsJSON, &input); err != nil {
+		return input, err
+	}
+	return input, nil
+}
+
+// newToolResultError returns a tool error result with the message and error
+func newToolResultError(msg string, err error) *mcp.CallToolResult {
+	return &mcp.CallToolResult{
+		Content: []mcp.Content{&mcp.TextContent{Text: fmt.Sprintf("%s: %s", msg, err.Error())}},
+		IsError: true,
+	}
+}
+
+// Wrap returns the tool with its handler wrapped in middleware
+func (st ServerTool) Wrap(middleware ToolMiddleware) ServerTool {
+	st.Handler = middleware(st.Handler)
+	st.middleware = append(append([]ToolMiddleware{}, st.middleware...), middleware)
+	return st
+}
+
+// Register adds the tool to the MCP server
+func (st ServerTool) Register(s *mcp.Server) {
+	if st.addTyped != nil {
+		st.addTyped(s, &st.Tool, st.middleware)
+		return
+	}
+	s.AddTool(&st.Tool, mcp.ToolHandler(st.Handler))
+}
+
 // Toolset represents a group of related tools
 type Toolset struct {
 	Name        string
@@ -56,11 +186,11 @@ func (t *Toolset) RegisterTools(s *mcp.Server) {
 		return
 	}
 	for _, tool := range t.readTools {
-		s.AddTool(&tool.Tool, mcp.ToolHandler(tool.Handler))
+		tool.Register(s)
 	}
 	if !t.readOnly {
 		for _, tool := range t.writeTools {
-			s.AddTool(&tool.Tool, mcp.ToolHandler(tool.Handler))
+			tool.Register(s)
 		}
 	}
 }
@@ -145,8 +275,38 @@ func (tg *ToolsetGroup) IsEnabled(name string) bool {
 	return toolset.Enabled
 }
 
-// EnableToolsets enables multiple toolsets
+// ToolsetNames returns the names of the toolsets in the group, sorted
+func (tg *ToolsetGroup) ToolsetNames() []string {
+	names := make([]string, 0, len(tg.Toolsets))
+	for name := range tg.Toolsets {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// EnableToolsets enables multiple toolsets, or every toolset for the name "all".
+// If any of the names is unknown, or names a toolset that has no tools in read-only mode,
+// it enables none and returns an error listing them.
 func (tg *ToolsetGroup) EnableToolsets(names []string) error {
+	var unknown, empty []string
+	for _, name := range names {
+		toolset, exists := tg.Toolsets[name]
+		switch {
+		case name == "all":
+		case !exists:
+			unknown = append(unknown, name)
+		case tg.readOnly && len(toolset.GetAvailableTools()) == 0:
+			empty = append(empty, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown toolsets: %s (available: %s, all)", strings.Join(unknown, ", "), strings.Join(tg.ToolsetNames(), ", "))
+	}
+	if len(empty) > 0 {
+		return fmt.Errorf("toolsets without tools in read-only mode: %s", strings.Join(empty, ", "))
+	}
+
 	for _, name := range names {
 		if name == "all" {
 			tg.everythingOn = true
@@ -0,0 +1,79 @@
+package toolsets
+
+import (
+	"testing"
+
+	"github.com/modelcontextprotocol/go-sdk/mcp"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestGroup returns a group with a notes toolset of read and write tools and a sync
+// toolset of write tools only
+func newTestGroup(readOnly bool) *ToolsetGroup {
+	readTool := NewServerTool(mcp.Tool{Name: "get_notes", Annotations: &mcp.ToolAnnotations{ReadOnlyHint: true}}, nil)
+	writeTool := NewServerTool(mcp.Tool{Name: "add_note"}, nil)
+	syncTool := NewServerTool(mcp.Tool{Name: "sync_notes"}, nil)
+
+	group := NewToolsetGroup(readOnly)
+	notes := NewToolset("notes", "Note tools")
+	notes.AddReadTools(readTool)
+	sync := NewToolset("sync", "Sync tools")
+	if !readOnly {
+		notes.AddWriteTools(writeTool)
+		sync.AddWriteTools(syncTool)
+	}
+	group.AddToolset(notes)
+	group.AddToolset(sync)
+	return group
+}
+
+func TestEnableToolsets(t *testing.T) {
+	tests := []struct {
+		name        string
+		names       []string
+		readOnly    bool
+		wantErr     string
+		wantEnabled []string
+	}{
+		{name: "known toolset", names: []string{"notes"}, wantEnabled: []string{"notes"}},
+		{name: "all", names: []string{"all"}, wantEnabled: []string{"notes", "sync"}},
+		{name: "all in read-only mode", names: []string{"all"}, readOnly: true, wantEnabled: []string{"notes", "sync"}},
+		{name: "unknown toolset", names: []string{"tags"}, wantErr: "unknown toolsets: tags (available: notes, sync, all)"},
+		{name: "unknown among known toolsets", names: []string{"notes", "tags", "files"}, wantErr: "unknown toolsets: tags, files (available: notes, sync, all)"},
+		{name: "unknown toolset in read-only mode", names: []string{"tags"}, readOnly: true, wantErr: "unknown toolsets: tags (available: notes, sync, all)"},
+		{name: "names are case-sensitive", names: []string{"Notes"}, wantErr: "unknown toolsets: Notes (available: notes, sync, all)"},
+		{name: "toolset without read tools in read-only mode", names: []string{"notes", "sync"}, readOnly: true, wantErr: "toolsets without tools in read-only mode: sync"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			group := newTestGroup(tt.readOnly)
+			err := group.EnableToolsets(tt.names)
+			if tt.wantErr != "" {
+				assert.EqualError(t, err, tt.wantErr)
+				for _, name := range group.ToolsetNames() {
+					assert.False(t, group.IsEnabled(name), "no toolset is enabled after an error")
+				}
+				return
+			}
+
+			require.NoError(t, err)
+			for _, name := range tt.wantEnabled {
+				assert.True(t, group.IsEnabled(name), name)
+			}
+		})
+	}
+}
+
+func TestToolsetReadOnly(t *testing.T) {
+	group := newTestGroup(true)
+	require.NoError(t, group.EnableToolsets([]string{"notes"}))
+
+	var names []string
+	for _, tool := range group.Toolsets["notes"].GetActiveTools() {
+		names = append(names, tool.Tool.Name)
+	}
+	assert.Equal(t, []string{"get_notes"}, names)
+	assert.Empty(t, group.Toolsets["sync"].GetAvailableTools())
+}